@@ -0,0 +1,101 @@
+package pixel
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+)
+
+// Animation is a Sprite which cycles through a list of frames of a single Picture, such as a
+// sprite sheet.
+//
+// Advance the Animation by the elapsed time each frame and draw it like a Sprite:
+//
+//   anim := pixel.NewAnimation(sheet, frames)
+//   anim.Advance(dt, 12) // 12 frames per second
+//   anim.Draw(win, pixel.IM.Moved(pos))
+//
+// By default, the Animation loops back to the first frame after the last one. Use SetClamp to make
+// it stop at the last frame instead.
+type Animation struct {
+	sprite *Sprite
+	frames []Rect
+	index  int
+	time   float64
+	clamp  bool
+}
+
+// NewAnimation creates an Animation from the supplied frames of a Picture, starting at the first
+// frame.
+//
+// Panics if there are no frames.
+func NewAnimation(pic Picture, frames []Rect) *Animation {
+	if len(frames) == 0 {
+		panic(fmt.Errorf("NewAnimation: no frames"))
+	}
+	return &Animation{
+		sprite: NewSprite(pic, frames[0]),
+		frames: frames,
+	}
+}
+
+// SetClamp sets whether the Animation stops at the last frame instead of looping back to the
+// first one.
+func (a *Animation) SetClamp(clamp bool) {
+	a.clamp = clamp
+}
+
+// Index returns the index of the current frame.
+func (a *Animation) Index() int {
+	return a.index
+}
+
+// SetIndex sets the current frame to the frame with the given index and resets the time spent in
+// it.
+//
+// Panics if the index is not within the frames of the Animation.
+func (a *Animation) SetIndex(i int) {
+	if i < 0 || i >= len(a.frames) {
+		panic(fmt.Errorf("(%T).SetIndex: index %d out of range of %d frames", a, i, len(a.frames)))
+	}
+	a.index = i
+	a.time = 0
+	a.sprite.Set(a.sprite.Picture(), a.frames[i])
+}
+
+// Advance moves the Animation forward by dt seconds, showing fps frames per second.
+func (a *Animation) Advance(dt, fps float64) {
+	a.time += dt * fps
+	steps := math.Floor(a.time)
+	a.time -= steps
+
+	n := len(a.frames)
+	index := a.index + int(steps)
+	if a.clamp {
+		if index >= n-1 {
+			index, a.time = n-1, 0
+		}
+		if index < 0 {
+			index, a.time = 0, 0
+		}
+	} else {
+		index = (index%n + n) % n
+	}
+
+	a.index = index
+	a.sprite.Set(a.sprite.Picture(), a.frames[index])
+}
+
+// Draw draws the current frame of the Animation onto the provided Target. The frame will be
+// transformed by the given Matrix.
+func (a *Animation) Draw(t Target, matrix Matrix) {
+	a.sprite.Draw(t, matrix)
+}
+
+// DrawColorMask draws the current frame of the Animation onto the provided Target. The frame will
+// be transformed by the given Matrix and all of it's color will be multiplied by the given mask.
+//
+// If the mask is nil, a fully opaque white mask will be used, which causes no effect.
+func (a *Animation) DrawColorMask(t Target, matrix Matrix, mask color.Color) {
+	a.sprite.DrawColorMask(t, matrix, mask)
+}
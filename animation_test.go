@@ -0,0 +1,78 @@
+package pixel_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestAnimation_Advance(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(0, 0, 40, 10))
+	frames := []pixel.Rect{
+		pixel.R(0, 0, 10, 10),
+		pixel.R(10, 0, 20, 10),
+		pixel.R(20, 0, 30, 10),
+		pixel.R(30, 0, 40, 10),
+	}
+	tests := []struct {
+		name  string
+		clamp bool
+		steps []float64
+		want  int
+	}{
+		{name: "Within frame", steps: []float64{0.01, 0.02}, want: 0},
+		{name: "Accumulated time", steps: []float64{0.03, 0.03, 0.03}, want: 1},
+		{name: "Loop", steps: []float64{0.1, 0.15}, want: 1},
+		{name: "Clamp", clamp: true, steps: []float64{0.1, 0.15}, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anim := pixel.NewAnimation(pic, frames)
+			anim.SetClamp(tt.clamp)
+			for _, dt := range tt.steps {
+				anim.Advance(dt, 20)
+			}
+			if got := anim.Index(); got != tt.want {
+				t.Errorf("Animation.Index() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnimation_Draw(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(0, 0, 2, 1))
+	pic.Pix[pic.Index(pixel.V(0, 0))] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(1, 0))] = color.RGBA{G: 0xff, A: 0xff}
+
+	anim := pixel.NewAnimation(pic, []pixel.Rect{pixel.R(0, 0, 1, 1), pixel.R(1, 0, 2, 1)})
+	it := pixel.NewImageTarget(pixel.R(0, 0, 1, 1))
+
+	anim.Draw(it, pixel.IM.Moved(pixel.V(0.5, 0.5)))
+	if got := it.Color(pixel.V(0.5, 0.5)); got != pixel.RGB(1, 0, 0) {
+		t.Errorf("first frame drew %v, want %v", got, pixel.RGB(1, 0, 0))
+	}
+
+	anim.SetIndex(1)
+	anim.Draw(it, pixel.IM.Moved(pixel.V(0.5, 0.5)))
+	if got := it.Color(pixel.V(0.5, 0.5)); got != pixel.RGB(0, 1, 0) {
+		t.Errorf("second frame drew %v, want %v", got, pixel.RGB(0, 1, 0))
+	}
+}
+
+func TestAnimation_SetIndexOutOfRange(t *testing.T) {
+	anim := pixel.NewAnimation(pixel.MakePictureData(pixel.R(0, 0, 2, 1)), []pixel.Rect{pixel.R(0, 0, 1, 1), pixel.R(1, 0, 2, 1)})
+	for _, i := range []int{-1, 2} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetIndex(%d) did not panic with 2 frames", i)
+				}
+			}()
+			anim.SetIndex(i)
+		}()
+	}
+	if got := anim.Index(); got != 0 {
+		t.Errorf("Index() after failed SetIndex = %d, want 0", got)
+	}
+}
@@ -9,14 +9,24 @@ import (
 //
 // To put an object into a Batch, just draw it onto it:
 //   object.Draw(batch)
+//
+// Normally, all objects drawn onto a Batch must use the Batch's Picture. With SetAutoFlush, a Batch
+// can accept objects with different Pictures too, it flushes it's content whenever the Picture
+// changes.
 type Batch struct {
 	cont Drawer
 
 	mat Matrix
 	col RGBA
+
+	flushTarget Target
+	flushCount  int
 }
 
-var _ BasicTarget = (*Batch)(nil)
+var (
+	_ BasicTarget = (*Batch)(nil)
+	_ Drawable    = (*Batch)(nil)
+)
 
 // NewBatch creates an empty Batch with the specified Picture and container.
 //
@@ -54,6 +64,56 @@ func (b *Batch) Draw(t Target) {
 	b.cont.Draw(t)
 }
 
+// SetAutoFlush sets a Target the Batch automatically flushes it's content onto, whenever an object
+// with a different Picture than the current one is drawn onto the Batch. The Batch then continues
+// with the new Picture. Grouping the drawn objects by their Pictures minimizes the number of
+// flushes.
+//
+//   batch.SetAutoFlush(win)
+//   for _, s := range sprites {
+//   	s.Draw(batch, s.matrix) // sprites may use different Pictures
+//   }
+//   batch.Flush() // draw the rest
+//
+// Setting nil disables the automatic flushing, which is the default. In that case, drawing an
+// object with a different Picture panics.
+func (b *Batch) SetAutoFlush(t Target) {
+	b.flushTarget = t
+}
+
+// Flush draws all objects in the Batch onto the Target set by SetAutoFlush and clears the Batch.
+// If no Target is set, it does nothing.
+func (b *Batch) Flush() {
+	if b.flushTarget == nil {
+		return
+	}
+	if b.cont.Triangles.Len() > 0 {
+		b.cont.Draw(b.flushTarget)
+		b.flushCount++
+	}
+	b.Clear()
+}
+
+// FlushCount returns the number of times the Batch has drawn it's content onto the Target set by
+// SetAutoFlush, either automatically or by calling Flush. Empty Batches are not drawn and not
+// counted.
+func (b *Batch) FlushCount() int {
+	return b.flushCount
+}
+
+// setPicture flushes the Batch and switches it to a different Picture, if the flushing is enabled.
+// Otherwise it panics.
+func (b *Batch) setPicture(p Picture, method string) {
+	if p == b.cont.Picture {
+		return
+	}
+	if b.flushTarget == nil {
+		panic(fmt.Errorf("(%T).%s: Picture is not the Batch's Picture", b, method))
+	}
+	b.Flush()
+	b.cont.Picture = p
+}
+
 // SetMatrix sets a Matrix that every point will be projected by.
 func (b *Batch) SetMatrix(m Matrix) {
 	b.mat = m
@@ -80,7 +140,7 @@ func (b *Batch) MakeTriangles(t Triangles) TargetTriangles {
 
 // MakePicture returns a specialized copy of the provided Picture that draws onto this Batch.
 func (b *Batch) MakePicture(p Picture) TargetPicture {
-	if p != b.cont.Picture {
+	if p != b.cont.Picture && b.flushTarget == nil {
 		panic(fmt.Errorf("(%T).MakePicture: Picture is not the Batch's Picture", b))
 	}
 	bp := &batchPicture{
@@ -159,5 +219,6 @@ func (bp *batchPicture) Draw(t TargetTriangles) {
 	if bp.dst != bt.dst {
 		panic(fmt.Errorf("(%T).Draw: TargetTriangles generated by different Batch", bp))
 	}
+	bp.dst.setPicture(bp.pic, "Draw")
 	bt.draw(bp)
 }
@@ -0,0 +1,56 @@
+package pixel_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestBatch_SetAutoFlush(t *testing.T) {
+	picture := func(c color.RGBA) *pixel.PictureData {
+		pic := pixel.MakePictureData(pixel.R(0, 0, 1, 1))
+		pic.Pix[0] = c
+		return pic
+	}
+	var (
+		red   = picture(color.RGBA{R: 0xff, A: 0xff})
+		green = picture(color.RGBA{G: 0xff, A: 0xff})
+	)
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 4, 1))
+	batch := pixel.NewBatch(&pixel.TrianglesData{}, red)
+	batch.SetAutoFlush(it)
+
+	redSprite, greenSprite := pixel.NewSprite(red, red.Bounds()), pixel.NewSprite(green, green.Bounds())
+	redSprite.Draw(batch, pixel.IM.Moved(pixel.V(0.5, 0.5)))
+	redSprite.Draw(batch, pixel.IM.Moved(pixel.V(1.5, 0.5)))
+	greenSprite.Draw(batch, pixel.IM.Moved(pixel.V(2.5, 0.5)))
+	if got := batch.FlushCount(); got != 1 {
+		t.Errorf("FlushCount() after picture change = %d, want 1", got)
+	}
+	redSprite.Draw(batch, pixel.IM.Moved(pixel.V(3.5, 0.5)))
+	batch.Flush()
+	batch.Flush() // empty Batch is not counted
+	if got := batch.FlushCount(); got != 3 {
+		t.Errorf("FlushCount() = %d, want 3", got)
+	}
+
+	want := []pixel.RGBA{pixel.RGB(1, 0, 0), pixel.RGB(1, 0, 0), pixel.RGB(0, 1, 0), pixel.RGB(1, 0, 0)}
+	for x := range want {
+		at := pixel.V(float64(x)+0.5, 0.5)
+		if got := it.Color(at); got != want[x] {
+			t.Errorf("Color(%v) = %v, want %v", at, got, want[x])
+		}
+	}
+}
+
+func TestBatch_MakePicture_Mismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MakePicture() did not panic on a different Picture without auto flush")
+		}
+	}()
+	batch := pixel.NewBatch(&pixel.TrianglesData{}, pixel.MakePictureData(pixel.R(0, 0, 1, 1)))
+	batch.MakePicture(pixel.MakePictureData(pixel.R(0, 0, 1, 1)))
+}
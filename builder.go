@@ -0,0 +1,77 @@
+package pixel
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// TrianglesBuilder builds TrianglesData incrementally, one vertex or one triangle at a time, without
+// tracking indices. This is handy for procedural geometry:
+//
+//   var tb pixel.TrianglesBuilder
+//   tb.SetColor(colornames.Green)
+//   for _, c := range cells {
+//   	tb.Triangle(c.a, c.b, c.c)
+//   }
+//   tri, err := tb.Build()
+//
+// The zero value of TrianglesBuilder is ready to use.
+type TrianglesBuilder struct {
+	td  TrianglesData
+	col color.Color
+}
+
+// SetColor sets the color of the vertices added by Triangle. If the color is nil, which is the
+// default, the vertices are white.
+func (tb *TrianglesBuilder) SetColor(c color.Color) {
+	tb.col = c
+}
+
+// Vertex adds a single vertex with the given position, color and Picture coordinates. A nil color
+// is white. The Picture intensity of the vertex is 1. If the built TrianglesData are drawn without
+// a Picture, the Picture coordinates are ignored.
+//
+// Every three vertices form a triangle.
+func (tb *TrianglesBuilder) Vertex(pos Vec, col color.Color, pic Vec) {
+	tb.td = append(tb.td, zeroValueTriangleData)
+	v := &tb.td[len(tb.td)-1]
+	v.Position = pos
+	if col != nil {
+		v.Color = ToRGBA(col)
+	}
+	v.Picture = pic
+	v.Intensity = 1
+}
+
+// Triangle adds a triangle with the given vertices in the color set by SetColor and without a
+// Picture.
+func (tb *TrianglesBuilder) Triangle(a, b, c Vec) {
+	col := Alpha(1)
+	if tb.col != nil {
+		col = ToRGBA(tb.col)
+	}
+	for _, u := range [...]Vec{a, b, c} {
+		tb.td = append(tb.td, zeroValueTriangleData)
+		tb.td[len(tb.td)-1].Position = u
+		tb.td[len(tb.td)-1].Color = col
+	}
+}
+
+// Len returns the number of vertices added so far.
+func (tb *TrianglesBuilder) Len() int {
+	return len(tb.td)
+}
+
+// Build returns the TrianglesData of all added vertices and empties the TrianglesBuilder, so it can
+// be used to build another TrianglesData. The color set by SetColor is kept.
+//
+// If the number of vertices is not a multiple of three, so the last triangle is incomplete, an
+// error is returned and the TrianglesBuilder is left unchanged.
+func (tb *TrianglesBuilder) Build() (*TrianglesData, error) {
+	if len(tb.td)%3 != 0 {
+		return nil, fmt.Errorf("(%T).Build: %d vertices do not form whole triangles", tb, len(tb.td))
+	}
+	td := tb.td
+	tb.td = nil
+	return &td, nil
+}
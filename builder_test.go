@@ -0,0 +1,52 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestTrianglesBuilder(t *testing.T) {
+	var tb pixel.TrianglesBuilder
+	tb.Triangle(pixel.V(0, 0), pixel.V(1, 0), pixel.V(0, 1))
+	tb.SetColor(pixel.RGB(1, 0, 0))
+	tb.Triangle(pixel.V(1, 1), pixel.V(2, 1), pixel.V(1, 2))
+	tb.Vertex(pixel.V(3, 3), pixel.RGB(0, 0, 1), pixel.V(5, 6))
+
+	if _, err := tb.Build(); err == nil {
+		t.Fatalf("TrianglesBuilder.Build() of an incomplete triangle did not return an error")
+	}
+	tb.Vertex(pixel.V(4, 3), nil, pixel.V(6, 6))
+	tb.Vertex(pixel.V(3, 4), pixel.RGB(0, 0, 1), pixel.V(5, 7))
+	if tb.Len() != 9 {
+		t.Fatalf("TrianglesBuilder.Len() = %d, want 9", tb.Len())
+	}
+
+	tri, err := tb.Build()
+	if err != nil {
+		t.Fatalf("TrianglesBuilder.Build() error = %v", err)
+	}
+	want := pixel.TrianglesData{
+		{Position: pixel.V(0, 0), Color: pixel.Alpha(1)},
+		{Position: pixel.V(1, 0), Color: pixel.Alpha(1)},
+		{Position: pixel.V(0, 1), Color: pixel.Alpha(1)},
+		{Position: pixel.V(1, 1), Color: pixel.RGB(1, 0, 0)},
+		{Position: pixel.V(2, 1), Color: pixel.RGB(1, 0, 0)},
+		{Position: pixel.V(1, 2), Color: pixel.RGB(1, 0, 0)},
+		{Position: pixel.V(3, 3), Color: pixel.RGB(0, 0, 1), Picture: pixel.V(5, 6), Intensity: 1},
+		{Position: pixel.V(4, 3), Color: pixel.Alpha(1), Picture: pixel.V(6, 6), Intensity: 1},
+		{Position: pixel.V(3, 4), Color: pixel.RGB(0, 0, 1), Picture: pixel.V(5, 7), Intensity: 1},
+	}
+	if !tri.Equal(&want, 0) {
+		t.Errorf("TrianglesBuilder.Build() = %v, want %v", *tri, want)
+	}
+
+	// the builder is empty again and keeps it's color
+	tb.Triangle(pixel.V(0, 0), pixel.V(1, 0), pixel.V(0, 1))
+	if tb.Len() != 3 || tri.Len() != 9 {
+		t.Errorf("TrianglesBuilder not emptied by Build")
+	}
+	if next, _ := tb.Build(); next.Color(0) != pixel.RGB(1, 0, 0) {
+		t.Errorf("TrianglesBuilder color after Build = %v, want %v", next.Color(0), pixel.RGB(1, 0, 0))
+	}
+}
@@ -1,10 +1,22 @@
 package pixel
 
-import "image/color"
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+)
 
 // RGBA represents an alpha-premultiplied RGBA color with components within range [0, 1].
 //
 // The difference between color.RGBA is that the value range is [0, 1] and the values are floats.
+//
+// Non-premultiplied colors, such as color.NRGBA, get premultiplied when converted with ToRGBA. To
+// convert back, use color.NRGBAModel, which returns transparent black for zero alpha.
+//
+// Arithmetic methods, such as Add or Mul, don't clamp the components, so colors can be accumulated
+// outside of the [0, 1] range. Targets clamp the components when the color is finally drawn. Use
+// Clamped to clamp them explicitly.
 type RGBA struct {
 	R, G, B, A float64
 }
@@ -65,6 +77,52 @@ func (c RGBA) Scaled(scale float64) RGBA {
 	}
 }
 
+// Clamped returns color c with each component clamped to the range [0, 1].
+func (c RGBA) Clamped() RGBA {
+	return RGBA{
+		R: Clamp(c.R, 0, 1),
+		G: Clamp(c.G, 0, 1),
+		B: Clamp(c.B, 0, 1),
+		A: Clamp(c.A, 0, 1),
+	}
+}
+
+// The Rec. 709 weights of the red, green and blue components in the luminance of a color, used by
+// Luminance and the ColorMatrices.
+const (
+	lumaR = 0.2126
+	lumaG = 0.7152
+	lumaB = 0.0722
+)
+
+// Luminance returns the relative luminance of color c computed with the Rec. 709 weights:
+//
+//   0.2126*R + 0.7152*G + 0.0722*B
+//
+// The components are used as they are, that is, gamma-encoded sRGB, not converted to linear light.
+// Since RGBA is alpha-premultiplied, the color is converted to a straight color first, so the
+// luminance doesn't depend on the opacity. A fully transparent color has luminance 0.
+func (c RGBA) Luminance() float64 {
+	if c.A == 0 {
+		return 0
+	}
+	return (lumaR*c.R + lumaG*c.G + lumaB*c.B) / c.A
+}
+
+// Grayscale returns a shade of gray with the same Luminance and alpha as color c.
+func (c RGBA) Grayscale() RGBA {
+	l := c.Luminance() * c.A
+	return RGBA{l, l, l, c.A}
+}
+
+// LerpRGBA returns a linear interpolation between colors a and b, just like Lerp does with vectors.
+//
+// If t is 0, then a will be returned, if t is 1, b will be returned. Values of t outside of the
+// [0, 1] range extrapolate and the result is not clamped.
+func LerpRGBA(a, b RGBA, t float64) RGBA {
+	return a.Scaled(1 - t).Add(b.Scaled(t))
+}
+
 // RGBA returns alpha-premultiplied red, green, blue and alpha components of the RGBA color.
 func (c RGBA) RGBA() (r, g, b, a uint32) {
 	r = uint32(0xffff * c.R)
@@ -74,6 +132,60 @@ func (c RGBA) RGBA() (r, g, b, a uint32) {
 	return
 }
 
+// RGBAFromHex parses a color from a hexadecimal string, such as the ones used in CSS. The string
+// has the form #RRGGBB or #RRGGBBAA, or a shorthand #RGB or #RGBA, where each digit is doubled.
+// The leading # is optional and the case of the digits doesn't matter.
+//
+// The components in the string are not alpha-premultiplied, the returned color is.
+//
+//   c, err := pixel.RGBAFromHex("#ff800080") // c is orange with alpha 0.5
+func RGBAFromHex(s string) (RGBA, error) {
+	hex := strings.TrimPrefix(s, "#")
+	switch len(hex) {
+	case 3, 4:
+		long := make([]byte, 0, 2*len(hex))
+		for i := 0; i < len(hex); i++ {
+			long = append(long, hex[i], hex[i])
+		}
+		hex = string(long)
+	case 6, 8:
+	default:
+		return RGBA{}, fmt.Errorf("RGBAFromHex: %q is not of form #RRGGBB, #RRGGBBAA, #RGB or #RGBA", s)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+
+	var c [4]float64
+	for i := range c {
+		x, err := strconv.ParseUint(hex[2*i:2*i+2], 16, 8)
+		if err != nil {
+			return RGBA{}, fmt.Errorf("RGBAFromHex: %q is not a hexadecimal color", s)
+		}
+		c[i] = float64(x) / 0xff
+	}
+	return RGB(c[0], c[1], c[2]).Mul(Alpha(c[3])), nil
+}
+
+// Hex returns the color as a hexadecimal string of the form #rrggbb, which is parsed by
+// RGBAFromHex. If the color is not fully opaque, the alpha is included in the form #rrggbbaa.
+//
+// The components in the string are not alpha-premultiplied and are clamped to [0, 1].
+func (c RGBA) Hex() string {
+	c = c.Clamped()
+	if c.A == 0 {
+		return "#00000000"
+	}
+	toByte := func(x float64) uint8 {
+		return uint8(Clamp(x, 0, 1)*0xff + 0.5)
+	}
+	r, g, b, a := toByte(c.R/c.A), toByte(c.G/c.A), toByte(c.B/c.A), toByte(c.A)
+	if a == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", r, g, b, a)
+}
+
 // ToRGBA converts a color to RGBA format. Using this function is preferred to using RGBAModel, for
 // performance (using RGBAModel introduces additional unnecessary allocations).
 func ToRGBA(c color.Color) RGBA {
@@ -3,6 +3,7 @@ package pixel_test
 import (
 	"fmt"
 	"image/color"
+	"math"
 	"testing"
 
 	"github.com/faiface/pixel"
@@ -22,3 +23,189 @@ func BenchmarkColorToRGBA(b *testing.B) {
 		})
 	}
 }
+
+func TestRGBA_Premultiplied(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.NRGBA
+		want pixel.RGBA
+	}{
+		{name: "Opaque", c: color.NRGBA{R: 0xff, G: 0x00, B: 0xff, A: 0xff}, want: pixel.RGB(1, 0, 1)},
+		{name: "Half transparent", c: color.NRGBA{R: 0xff, G: 0xff, B: 0x00, A: 0x80}, want: pixel.RGB(1, 1, 0).Mul(pixel.Alpha(0x80 / 255.0))},
+		{name: "Fully transparent", c: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x00}, want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.ToRGBA(tt.c)
+			for _, d := range []float64{got.R - tt.want.R, got.G - tt.want.G, got.B - tt.want.B, got.A - tt.want.A} {
+				if d < -1e-3 || d > 1e-3 {
+					t.Fatalf("ToRGBA(%v) = %v, want %v", tt.c, got, tt.want)
+				}
+			}
+			if tt.c.A == 0 {
+				tt.c = color.NRGBA{}
+			}
+			if back := color.NRGBAModel.Convert(got); back != tt.c {
+				t.Errorf("NRGBAModel.Convert(%v) = %v, want %v", got, back, tt.c)
+			}
+		})
+	}
+}
+
+func TestRGBAModel(t *testing.T) {
+	// round trip of opaque colors through RGBA is lossless for all 8-bit levels
+	for x := 0; x <= 0xff; x++ {
+		c := color.NRGBA{R: uint8(x), G: uint8(0xff - x), B: uint8(x / 2), A: 0xff}
+		got := pixel.RGBAModel.Convert(c)
+		if _, ok := got.(pixel.RGBA); !ok {
+			t.Fatalf("RGBAModel.Convert(%v) returned %T, want pixel.RGBA", c, got)
+		}
+		if back := color.NRGBAModel.Convert(got); back != c {
+			t.Errorf("NRGBAModel.Convert(RGBAModel.Convert(%v)) = %v", c, back)
+		}
+	}
+}
+
+func TestRGBAFromHex(t *testing.T) {
+	tests := []struct {
+		hex     string
+		want    pixel.RGBA
+		wantErr bool
+	}{
+		{hex: "#ff8000", want: pixel.RGB(1, 0x80/255.0, 0)},
+		{hex: "FF8000", want: pixel.RGB(1, 0x80/255.0, 0)},
+		{hex: "#ff800080", want: pixel.RGB(1, 0x80/255.0, 0).Mul(pixel.Alpha(0x80 / 255.0))},
+		{hex: "#f80", want: pixel.RGB(1, 0x88/255.0, 0)},
+		{hex: "#f808", want: pixel.RGB(1, 0x88/255.0, 0).Mul(pixel.Alpha(0x88 / 255.0))},
+		{hex: "#ff80", want: pixel.RGBA{}},
+		{hex: "#ff800", wantErr: true},
+		{hex: "#gg8000", wantErr: true},
+		{hex: "#+f8000", wantErr: true},
+		{hex: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hex, func(t *testing.T) {
+			got, err := pixel.RGBAFromHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RGBAFromHex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("RGBAFromHex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBA_Hex(t *testing.T) {
+	tests := []struct {
+		c    pixel.RGBA
+		want string
+	}{
+		{c: pixel.RGB(1, 0.5, 0), want: "#ff8000"},
+		{c: pixel.RGB(1, 0.5, 0).Mul(pixel.Alpha(0.5)), want: "#ff800080"},
+		{c: pixel.RGBA{R: 2, G: -1, B: 0, A: 1}, want: "#ff0000"},
+		{c: pixel.RGBA{}, want: "#00000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.c.Hex(); got != tt.want {
+				t.Errorf("RGBA.Hex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// round trip of opaque colors is lossless for all 8-bit levels
+	for x := 0; x <= 0xff; x++ {
+		hex := fmt.Sprintf("#%02x%02x%02x", x, 0xff-x, x/2)
+		c, err := pixel.RGBAFromHex(hex)
+		if err != nil {
+			t.Fatalf("RGBAFromHex(%q) error = %v", hex, err)
+		}
+		if got := c.Hex(); got != hex {
+			t.Errorf("RGBAFromHex(%q).Hex() = %v", hex, got)
+		}
+	}
+}
+
+func TestRGBA_Clamped(t *testing.T) {
+	tests := []struct {
+		name string
+		c    pixel.RGBA
+		want pixel.RGBA
+	}{
+		{name: "In range", c: pixel.RGBA{R: 0.2, G: 0.4, B: 0.6, A: 0.8}, want: pixel.RGBA{R: 0.2, G: 0.4, B: 0.6, A: 0.8}},
+		{name: "Above range", c: pixel.RGB(0.8, 0.9, 1).Add(pixel.RGB(0.5, 0.5, 0.5)), want: pixel.RGBA{R: 1, G: 1, B: 1, A: 1}},
+		{name: "Below range", c: pixel.RGBA{R: -1, G: 0.5, B: -0.1, A: 1}, want: pixel.RGBA{R: 0, G: 0.5, B: 0, A: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Clamped(); got != tt.want {
+				t.Errorf("RGBA.Clamped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLerpRGBA(t *testing.T) {
+	a, b := pixel.RGB(1, 0, 0), pixel.RGBA{R: 0, G: 0.5, B: 0, A: 0.5}
+	tests := []struct {
+		name string
+		t    float64
+		want pixel.RGBA
+	}{
+		{name: "Start", t: 0, want: a},
+		{name: "End", t: 1, want: b},
+		{name: "Middle", t: 0.5, want: pixel.RGBA{R: 0.5, G: 0.25, B: 0, A: 0.75}},
+		{name: "Overshoot", t: 2, want: pixel.RGBA{R: -1, G: 1, B: 0, A: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pixel.LerpRGBA(a, b, tt.t); got != tt.want {
+				t.Errorf("LerpRGBA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBA_Luminance(t *testing.T) {
+	tests := []struct {
+		name string
+		c    pixel.RGBA
+		want float64
+	}{
+		{name: "White", c: pixel.RGB(1, 1, 1), want: 1},
+		{name: "Black", c: pixel.RGB(0, 0, 0), want: 0},
+		{name: "Red", c: pixel.RGB(1, 0, 0), want: 0.2126},
+		{name: "Green", c: pixel.RGB(0, 1, 0), want: 0.7152},
+		{name: "Blue", c: pixel.RGB(0, 0, 1), want: 0.0722},
+		{name: "Translucent", c: pixel.RGB(0, 1, 0).Mul(pixel.Alpha(0.5)), want: 0.7152},
+		{name: "Transparent", c: pixel.Alpha(0), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Luminance(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RGBA.Luminance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBA_Grayscale(t *testing.T) {
+	tests := []struct {
+		name string
+		c    pixel.RGBA
+		want pixel.RGBA
+	}{
+		{name: "White", c: pixel.RGB(1, 1, 1), want: pixel.RGB(1, 1, 1)},
+		{name: "Red", c: pixel.RGB(1, 0, 0), want: pixel.RGB(0.2126, 0.2126, 0.2126)},
+		{name: "Translucent", c: pixel.RGBA{R: 0, G: 0.5, B: 0, A: 0.5}, want: pixel.RGBA{R: 0.3576, G: 0.3576, B: 0.3576, A: 0.5}},
+		{name: "Transparent", c: pixel.Alpha(0), want: pixel.Alpha(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Grayscale(); !rgbaNear(got, tt.want) {
+				t.Errorf("RGBA.Grayscale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
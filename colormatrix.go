@@ -0,0 +1,149 @@
+package pixel
+
+import "math"
+
+// ColorMatrix is a 4x5 matrix, which transforms colors, such as for grayscale, sepia or hue shift
+// effects.
+//
+// Each row computes one component of the resulting color, in order R, G, B and A, from the
+// components of the original color and a constant offset:
+//
+//   R' = m[0]*R + m[1]*G + m[2]*B + m[3]*A + m[4]
+//   G' = m[5]*R + m[6]*G + m[7]*B + m[8]*A + m[9]
+//   ...
+//
+// The matrix works with straight colors, that is, RGB not premultiplied by alpha, so the effects
+// don't depend on the opacity of a color.
+type ColorMatrix [20]float64
+
+// IdentityColorMatrix is a ColorMatrix, which keeps colors unchanged.
+var IdentityColorMatrix = ColorMatrix{
+	1, 0, 0, 0, 0,
+	0, 1, 0, 0, 0,
+	0, 0, 1, 0, 0,
+	0, 0, 0, 1, 0,
+}
+
+// GrayscaleColorMatrix is a ColorMatrix, which converts colors to shades of gray of the same
+// Luminance, just like RGBA.Grayscale.
+var GrayscaleColorMatrix = SaturationColorMatrix(0)
+
+// SepiaColorMatrix is a ColorMatrix, which gives colors the brownish tone of old photographs.
+var SepiaColorMatrix = ColorMatrix{
+	0.393, 0.769, 0.189, 0, 0,
+	0.349, 0.686, 0.168, 0, 0,
+	0.272, 0.534, 0.131, 0, 0,
+	0, 0, 0, 1, 0,
+}
+
+// InvertColorMatrix is a ColorMatrix, which inverts the RGB components of colors and keeps their
+// alpha.
+var InvertColorMatrix = ColorMatrix{
+	-1, 0, 0, 0, 1,
+	0, -1, 0, 0, 1,
+	0, 0, -1, 0, 1,
+	0, 0, 0, 1, 0,
+}
+
+// SaturationColorMatrix returns a ColorMatrix, which scales the saturation of colors, while keeping
+// their Luminance. Saturation 0 gives grayscale, 1 keeps colors unchanged and values above 1 make
+// them more vivid.
+func SaturationColorMatrix(s float64) ColorMatrix {
+	r, g, b := lumaR*(1-s), lumaG*(1-s), lumaB*(1-s)
+	return ColorMatrix{
+		r + s, g, b, 0, 0,
+		r, g + s, b, 0, 0,
+		r, g, b + s, 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// BrightnessColorMatrix returns a ColorMatrix, which adds b to the RGB components of colors.
+// Negative values darken the colors.
+func BrightnessColorMatrix(b float64) ColorMatrix {
+	return ColorMatrix{
+		1, 0, 0, 0, b,
+		0, 1, 0, 0, b,
+		0, 0, 1, 0, b,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// HueRotationColorMatrix returns a ColorMatrix, which rotates the hue of colors by the given angle
+// in radians, while keeping their Luminance.
+func HueRotationColorMatrix(angle float64) ColorMatrix {
+	sin, cos := math.Sincos(angle)
+
+	// the rotation around the gray axis, the middle row follows from keeping the luminance
+	var (
+		rs = [3]float64{-lumaR, -lumaG, 1 - lumaB}
+		gs = [3]float64{
+			(lumaR*lumaR + lumaB*(1-lumaR)) / lumaG,
+			lumaR - lumaB,
+			-(lumaR*(1-lumaB) + lumaB*lumaB) / lumaG,
+		}
+		bs = [3]float64{-(1 - lumaR), lumaG, lumaB}
+	)
+
+	return ColorMatrix{
+		lumaR + cos*(1-lumaR) + sin*rs[0], lumaG - cos*lumaG + sin*rs[1], lumaB - cos*lumaB + sin*rs[2], 0, 0,
+		lumaR - cos*lumaR + sin*gs[0], lumaG + cos*(1-lumaG) + sin*gs[1], lumaB - cos*lumaB + sin*gs[2], 0, 0,
+		lumaR - cos*lumaR + sin*bs[0], lumaG - cos*lumaG + sin*bs[1], lumaB + cos*(1-lumaB) + sin*bs[2], 0, 0,
+		0, 0, 0, 1, 0,
+	}
+}
+
+// Chained returns a ColorMatrix, which applies cm first and then next, just like Matrix.Chained.
+func (cm ColorMatrix) Chained(next ColorMatrix) ColorMatrix {
+	var result ColorMatrix
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 5; col++ {
+			sum := 0.0
+			for k := 0; k < 4; k++ {
+				sum += next[row*5+k] * cm[k*5+col]
+			}
+			if col == 4 {
+				sum += next[row*5+4]
+			}
+			result[row*5+col] = sum
+		}
+	}
+	return result
+}
+
+// Apply returns the color c transformed by the ColorMatrix. The color is alpha-premultiplied, just
+// like all RGBA, it's converted to a straight color for the transformation and back. The result is
+// clamped to [0, 1].
+func (cm ColorMatrix) Apply(c RGBA) RGBA {
+	if c.A > 0 {
+		c.R, c.G, c.B = c.R/c.A, c.G/c.A, c.B/c.A
+	}
+	in := [4]float64{c.R, c.G, c.B, c.A}
+
+	var out [4]float64
+	for row := range out {
+		out[row] = cm[row*5+4]
+		for k, x := range in {
+			out[row] += cm[row*5+k] * x
+		}
+		out[row] = Clamp(out[row], 0, 1)
+	}
+
+	a := out[3]
+	return RGBA{out[0] * a, out[1] * a, out[2] * a, a}
+}
+
+// ApplyColorMatrix returns a copy of an arbitrary Picture as PictureData with the color of each
+// pixel transformed by the ColorMatrix:
+//
+//   old := pixel.ApplyColorMatrix(photo, pixel.SepiaColorMatrix)
+//
+// Use ColorMatrix.Chained to apply multiple effects at once.
+func ApplyColorMatrix(pic Picture, cm ColorMatrix) *PictureData {
+	src := PictureDataFromPicture(pic)
+	dst := MakePictureData(src.Rect)
+	for i, pix := range src.Pix {
+		dst.Pix[i] = toColorRGBA(cm.Apply(fromColorRGBA(pix)))
+	}
+	return dst
+}
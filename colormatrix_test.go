@@ -0,0 +1,68 @@
+package pixel_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestColorMatrix_Apply(t *testing.T) {
+	halfRed := pixel.RGBA{R: 0.5, A: 0.5} // premultiplied
+	tests := []struct {
+		name string
+		cm   pixel.ColorMatrix
+		c    pixel.RGBA
+		want pixel.RGBA
+	}{
+		{name: "Identity", cm: pixel.IdentityColorMatrix, c: halfRed, want: halfRed},
+		{name: "Grayscale", cm: pixel.GrayscaleColorMatrix, c: pixel.RGB(1, 0, 0), want: pixel.RGB(0.2126, 0.2126, 0.2126)},
+		{name: "Grayscale like RGBA", cm: pixel.GrayscaleColorMatrix, c: pixel.RGB(0.3, 0.6, 0.9), want: pixel.RGB(0.3, 0.6, 0.9).Grayscale()},
+		{name: "Invert keeps alpha", cm: pixel.InvertColorMatrix, c: halfRed, want: pixel.RGBA{G: 0.5, B: 0.5, A: 0.5}},
+		{name: "Brightness clamps", cm: pixel.BrightnessColorMatrix(0.5), c: pixel.RGB(0.8, 0.2, 0), want: pixel.RGB(1, 0.7, 0.5)},
+		{name: "Sepia", cm: pixel.SepiaColorMatrix, c: pixel.RGB(1, 1, 1), want: pixel.RGB(1, 1, 0.937)},
+		{name: "Full hue rotation", cm: pixel.HueRotationColorMatrix(2 * math.Pi), c: pixel.RGB(0.2, 0.4, 0.6), want: pixel.RGB(0.2, 0.4, 0.6)},
+		{
+			name: "Chained",
+			cm:   pixel.InvertColorMatrix.Chained(pixel.BrightnessColorMatrix(-0.5)),
+			c:    pixel.RGB(0.2, 0.4, 1),
+			want: pixel.RGB(0.3, 0.1, 0),
+		},
+		{name: "Transparent", cm: pixel.InvertColorMatrix, c: pixel.RGBA{}, want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cm.Apply(tt.c); !rgbaNear(got, tt.want) {
+				t.Errorf("ColorMatrix.Apply(%v) = %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+
+	c := pixel.RGB(0.3, 0.5, 0.4)
+	for _, angle := range []float64{0.5, 1, 2} {
+		got := pixel.HueRotationColorMatrix(angle).Apply(c)
+		if math.Abs(got.Luminance()-c.Luminance()) > 1e-9 {
+			t.Errorf("HueRotationColorMatrix(%v) changed luminance from %v to %v", angle, c.Luminance(), got.Luminance())
+		}
+	}
+}
+
+func TestApplyColorMatrix(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(1, 1, 3, 2))
+	pic.Pix[0] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[1] = color.RGBA{B: 0x80, A: 0x80}
+
+	got := pixel.ApplyColorMatrix(pic, pixel.InvertColorMatrix)
+	if got.Bounds() != pic.Bounds() {
+		t.Fatalf("ApplyColorMatrix().Bounds() = %v, want %v", got.Bounds(), pic.Bounds())
+	}
+	for i, want := range []color.RGBA{{G: 0xff, B: 0xff, A: 0xff}, {R: 0x80, G: 0x80, A: 0x80}} {
+		if got.Pix[i] != want {
+			t.Errorf("ApplyColorMatrix() pixel %d = %v, want %v", i, got.Pix[i], want)
+		}
+	}
+	if pic.Pix[0] != (color.RGBA{R: 0xff, A: 0xff}) {
+		t.Errorf("ApplyColorMatrix() modified the source Picture")
+	}
+}
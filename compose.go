@@ -14,7 +14,12 @@ type ComposeTarget interface {
 type ComposeMethod int
 
 // Here's the list of all available Porter-Duff composition methods. Use ComposeOver for the basic
-// alpha blending.
+// alpha blending, which is the default.
+//
+// Besides them, there are two common blend modes. ComposePlus adds the colors together, which is
+// additive blending, a staple of glowing particles and lights. ComposeMultiply multiplies the
+// background by the foreground, which darkens it, like shadows or tinted glass. Multiplying keeps
+// the alpha of the background, so drawing onto a transparent background has no effect.
 const (
 	ComposeOver ComposeMethod = iota
 	ComposeIn
@@ -27,11 +32,17 @@ const (
 	ComposeXor
 	ComposePlus
 	ComposeCopy
+	ComposeMultiply
 )
 
 // Compose composes two colors together according to the ComposeMethod. A is the foreground, B is
 // the background.
 func (cm ComposeMethod) Compose(a, b RGBA) RGBA {
+	if cm == ComposeMultiply {
+		// the uncovered part of the background is kept as is
+		return a.Mul(b).Add(b.Mul(Alpha(1 - a.A)))
+	}
+
 	var fa, fb float64
 
 	switch cm {
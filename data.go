@@ -6,6 +6,8 @@ import (
 	"image/color"
 	"image/draw"
 	"math"
+	"math/rand"
+	"sync"
 )
 
 var (
@@ -27,6 +29,24 @@ type TrianglesData []struct {
 	Intensity float64
 }
 
+// vertex has the same underlying type as the elements of TrianglesData, so they convert to each
+// other.
+type vertex struct {
+	Position  Vec
+	Color     RGBA
+	Picture   Vec
+	Intensity float64
+}
+
+func lerpVertex(a, b vertex, t float64) vertex {
+	return vertex{
+		Position:  Lerp(a.Position, b.Position, t),
+		Color:     LerpRGBA(a.Color, b.Color, t),
+		Picture:   Lerp(a.Picture, b.Picture, t),
+		Intensity: a.Intensity + (b.Intensity-a.Intensity)*t,
+	}
+}
+
 // MakeTrianglesData creates TrianglesData of length len initialized with default property values.
 //
 // Prefer this function to make(TrianglesData, len), because make zeros them, while this function
@@ -39,6 +59,60 @@ func MakeTrianglesData(len int) *TrianglesData {
 	return &td
 }
 
+// TrianglesDataFromSlices creates TrianglesData from separate slices of vertex properties. All
+// non-nil slices must have the same length as the slice of positions, otherwise an error is
+// returned.
+//
+// If the colors are nil, all vertices are white. If the picture coordinates are nil, the vertices
+// have no picture, otherwise their picture intensity is 1.
+func TrianglesDataFromSlices(pos []Vec, col []RGBA, pic []Vec) (*TrianglesData, error) {
+	if col != nil && len(col) != len(pos) {
+		return nil, fmt.Errorf("TrianglesDataFromSlices: %d colors for %d positions", len(col), len(pos))
+	}
+	if pic != nil && len(pic) != len(pos) {
+		return nil, fmt.Errorf("TrianglesDataFromSlices: %d picture coordinates for %d positions", len(pic), len(pos))
+	}
+
+	td := MakeTrianglesData(len(pos))
+	for i := range *td {
+		(*td)[i].Position = pos[i]
+		if col != nil {
+			(*td)[i].Color = col[i]
+		}
+		if pic != nil {
+			(*td)[i].Picture = pic[i]
+			(*td)[i].Intensity = 1
+		}
+	}
+	return td, nil
+}
+
+// ToTrianglesData creates a new TrianglesData with a copy of the vertices of the supplied Triangles.
+//
+// TrianglesPosition, TrianglesColor and TrianglesPicture are supported. Properties not supported
+// by the supplied Triangles are set to default values.
+func ToTrianglesData(t Triangles) *TrianglesData {
+	td := MakeTrianglesData(t.Len())
+	td.updateData(t)
+	return td
+}
+
+// MergeTriangles creates a new TrianglesData with the vertices of all the supplied Triangles one
+// after another. It's the same as calling Append on empty TrianglesData for each of them.
+//
+// Use BlendSeams on the result to smooth the colors along the edges shared by the parts.
+func MergeTriangles(parts ...Triangles) *TrianglesData {
+	length := 0
+	for _, t := range parts {
+		length += t.Len()
+	}
+	td := make(TrianglesData, 0, length)
+	for _, t := range parts {
+		td.Append(t)
+	}
+	return &td
+}
+
 // Len returns the number of vertices in TrianglesData.
 func (td *TrianglesData) Len() int {
 	return len(*td)
@@ -108,6 +182,415 @@ func (td *TrianglesData) Copy() Triangles {
 	return copyTd
 }
 
+// Append adds the vertices of the supplied Triangles to the end of this TrianglesData.
+//
+// TrianglesPosition, TrianglesColor and TrianglesPicture are supported. Properties not supported
+// by the supplied Triangles are set to default values.
+func (td *TrianglesData) Append(t Triangles) {
+	// fast path optimization
+	if t, ok := t.(*TrianglesData); ok {
+		*td = append(*td, *t...)
+		return
+	}
+
+	// slow path manual copy
+	off := td.Len()
+	td.SetLen(off + t.Len())
+	td.Slice(off, td.Len()).Update(t)
+}
+
+// Transform projects the position property of all vertices by the given Matrix. Other properties
+// are left untouched.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) Transform(m Matrix) {
+	for i := range *td {
+		(*td)[i].Position = m.Project((*td)[i].Position)
+	}
+}
+
+// TransformColors replaces the color property of all vertices by the result of calling f on it.
+// Other properties are left untouched.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) TransformColors(f func(RGBA) RGBA) {
+	for i := range *td {
+		(*td)[i].Color = f((*td)[i].Color)
+	}
+}
+
+// SetColor sets the color property of i-th vertex to the given color, converted to RGBA.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) SetColor(i int, c color.Color) {
+	(*td)[i].Color = ToRGBA(c)
+}
+
+// SetAllColors sets the color property of all vertices to the given color, converted to RGBA.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) SetAllColors(c color.Color) {
+	rgba := ToRGBA(c)
+	for i := range *td {
+		(*td)[i].Color = rgba
+	}
+}
+
+// SetColorRange sets the color property of vertices from i-th to (j-1)-th to the given color,
+// converted to RGBA, just like SetColor does for a single vertex. This is useful for highlighting a
+// part of a mesh, such as a selected face.
+//
+// It panics if the range [i:j) is not within the TrianglesData.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) SetColorRange(i, j int, c color.Color) {
+	if i < 0 || j < i || j > td.Len() {
+		panic(fmt.Errorf("(%T).SetColorRange: range [%d:%d) out of bounds for length %d", td, i, j, td.Len()))
+	}
+	rgba := ToRGBA(c)
+	for k := i; k < j; k++ {
+		(*td)[k].Color = rgba
+	}
+}
+
+// SetAlpha multiplies the color of all vertices by the alpha a, clamped to [0, 1]. Since colors
+// are alpha-premultiplied, this fades the vertices out, all components of a color are multiplied.
+//
+// The colors are multiplied, not replaced, so to animate a fade, apply it to a fresh copy of the
+// original TrianglesData each frame.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) SetAlpha(a float64) {
+	alpha := Alpha(Clamp(a, 0, 1))
+	for i := range *td {
+		(*td)[i].Color = (*td)[i].Color.Mul(alpha).Clamped()
+	}
+}
+
+// Fade multiplies the color of all vertices by an alpha linearly interpolated between from and to
+// by t, just like SetAlpha does. If t is 0, the alpha is from, if t is 1, the alpha is to.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) Fade(from, to, t float64) {
+	td.SetAlpha(from + (to-from)*t)
+}
+
+// ForEachTriangle calls f for each triangle with the indices of it's three vertices in this
+// TrianglesData, in order.
+//
+// It panics if the length of the TrianglesData is not a multiple of three.
+func (td *TrianglesData) ForEachTriangle(f func(a, b, c int)) {
+	if td.Len()%3 != 0 {
+		panic(fmt.Errorf("(%T).ForEachTriangle: length %d is not a multiple of three", td, td.Len()))
+	}
+	for i := 0; i < td.Len(); i += 3 {
+		f(i, i+1, i+2)
+	}
+}
+
+// Filter returns a new TrianglesData with only those triangles, for which keep returns true. The
+// keep function is called for each triangle with the indices of it's three vertices in this
+// TrianglesData. Trailing vertices, which don't form a whole triangle, are left out.
+func (td *TrianglesData) Filter(keep func(tri [3]int) bool) *TrianglesData {
+	filtered := make(TrianglesData, 0, td.Len())
+	for i := 0; i+2 < td.Len(); i += 3 {
+		if keep([3]int{i, i + 1, i + 2}) {
+			filtered = append(filtered, (*td)[i:i+3]...)
+		}
+	}
+	return &filtered
+}
+
+// Subdivide returns a new TrianglesData, where each triangle is split into four triangles by the
+// midpoints of it's edges, repeatedly for the given number of levels. All properties of the new
+// vertices are averaged from the ends of their edge, so the shape stays the same, but colors are
+// interpolated over more vertices, which smooths gradients and lighting computed per vertex.
+//
+// Each level multiplies the number of triangles by four. Level 0 or less returns a copy. Trailing
+// vertices, which don't form a whole triangle, are left out.
+func (td *TrianglesData) Subdivide(levels int) *TrianglesData {
+	result := make(TrianglesData, td.Len()/3*3)
+	copy(result, *td)
+
+	for level := 0; level < levels; level++ {
+		divided := make(TrianglesData, 0, 4*len(result))
+		for i := 0; i+2 < len(result); i += 3 {
+			a, b, c := vertex(result[i]), vertex(result[i+1]), vertex(result[i+2])
+			ab, bc, ca := lerpVertex(a, b, 0.5), lerpVertex(b, c, 0.5), lerpVertex(c, a, 0.5)
+			divided = append(divided,
+				a, ab, ca,
+				ab, b, bc,
+				ca, bc, c,
+				ab, bc, ca,
+			)
+		}
+		result = divided
+	}
+	return &result
+}
+
+// Reverse flips the winding order of all triangles in place by swapping their second and third
+// vertex. Trailing vertices, which don't form a whole triangle, are left untouched.
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) Reverse() {
+	for i := 0; i+2 < td.Len(); i += 3 {
+		(*td)[i+1], (*td)[i+2] = (*td)[i+2], (*td)[i+1]
+	}
+}
+
+// Bounds returns the smallest Rect which contains the positions of all vertices. Empty
+// TrianglesData returns the zero Rect.
+func (td *TrianglesData) Bounds() Rect {
+	if td.Len() == 0 {
+		return Rect{}
+	}
+	bounds := Rect{Min: (*td)[0].Position, Max: (*td)[0].Position}
+	for _, v := range *td {
+		bounds.Min = bounds.Min.Min(v.Position)
+		bounds.Max = bounds.Max.Max(v.Position)
+	}
+	return bounds
+}
+
+// BoundingCircle returns a Circle which contains the positions of all vertices. Empty
+// TrianglesData returns the zero Circle.
+//
+// If exact is false, the Circle is centered in the middle of the Bounds and it's radius is the
+// distance to the farthest vertex. This is fast, but the Circle may be larger than necessary. If
+// exact is true, the smallest enclosing Circle is computed by Welzl's algorithm.
+func (td *TrianglesData) BoundingCircle(exact bool) Circle {
+	if td.Len() == 0 {
+		return Circle{}
+	}
+	if !exact {
+		center, radius := td.Bounds().Center(), 0.0
+		for _, v := range *td {
+			radius = math.Max(radius, center.To(v.Position).Len())
+		}
+		return C(center, radius)
+	}
+
+	// Welzl's algorithm runs in expected linear time only for points in random order, ordered input,
+	// such as an outline, is quadratic or worse, so the unique positions are shuffled first
+	seen := make(map[Vec]bool, td.Len())
+	points := make([]Vec, 0, td.Len())
+	for _, v := range *td {
+		if !seen[v.Position] {
+			seen[v.Position] = true
+			points = append(points, v.Position)
+		}
+	}
+	rnd := rand.New(rand.NewSource(int64(len(points))))
+	for i := len(points) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		points[i], points[j] = points[j], points[i]
+	}
+
+	// iterative form of Welzl's algorithm, each loop adds one point to the boundary of the circle
+	c := C(points[0], 0)
+	for i := 1; i < len(points); i++ {
+		p := points[i]
+		if circleContains(c, p) {
+			continue
+		}
+		c = C(p, 0)
+		for j := 0; j < i; j++ {
+			q := points[j]
+			if circleContains(c, q) {
+				continue
+			}
+			c = circleFromDiameter(p, q)
+			for k := 0; k < j; k++ {
+				r := points[k]
+				if !circleContains(c, r) {
+					c = circleThrough(p, q, r)
+				}
+			}
+		}
+	}
+	return c
+}
+
+func circleContains(c Circle, u Vec) bool {
+	return c.Center.To(u).Len() <= c.Radius*(1+1e-9)+1e-9
+}
+
+func circleFromDiameter(a, b Vec) Circle {
+	return C(Lerp(a, b, 0.5), a.To(b).Len()/2)
+}
+
+// circleThrough returns the circle passing through all three points. If the points are collinear,
+// the circle with the two farthest points as it's diameter is returned instead.
+func circleThrough(a, b, c Vec) Circle {
+	ab, ac := a.To(b), a.To(c)
+	d := 2 * ab.Cross(ac)
+	if d == 0 {
+		circle := circleFromDiameter(a, b)
+		for _, other := range []Circle{circleFromDiameter(a, c), circleFromDiameter(b, c)} {
+			if other.Radius > circle.Radius {
+				circle = other
+			}
+		}
+		return circle
+	}
+	offset := V(
+		ac.Y*ab.Dot(ab)-ab.Y*ac.Dot(ac),
+		ab.X*ac.Dot(ac)-ac.X*ab.Dot(ab),
+	).Scaled(1 / d)
+	return C(a.Add(offset), offset.Len())
+}
+
+// Equal checks whether the supplied Triangles have the same vertices as this TrianglesData, with
+// all properties equal within epsilon. Properties not supported by the supplied Triangles are
+// compared as default values.
+func (td *TrianglesData) Equal(t Triangles, epsilon float64) bool {
+	if td.Len() != t.Len() {
+		return false
+	}
+	other := ToTrianglesData(t)
+	for i := range *td {
+		if !td.vertexNear(i, other, i, epsilon) {
+			return false
+		}
+	}
+	return true
+}
+
+// vertexNear checks whether the i-th vertex of this TrianglesData has all properties equal within
+// epsilon to the j-th vertex of other.
+func (td *TrianglesData) vertexNear(i int, other *TrianglesData, j int, epsilon float64) bool {
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) <= epsilon
+	}
+	u, v := (*td)[i], (*other)[j]
+	return near(u.Position.X, v.Position.X) && near(u.Position.Y, v.Position.Y) &&
+		near(u.Color.R, v.Color.R) && near(u.Color.G, v.Color.G) &&
+		near(u.Color.B, v.Color.B) && near(u.Color.A, v.Color.A) &&
+		near(u.Picture.X, v.Picture.X) && near(u.Picture.Y, v.Picture.Y) &&
+		near(u.Intensity, v.Intensity)
+}
+
+// Compact returns IndexedTriangles with the same vertices as this TrianglesData, where vertices
+// with all properties equal within epsilon share a single index. Each shared vertex has the
+// properties of it's first occurrence, so the result draws the same as the TrianglesData within
+// epsilon.
+//
+// This reduces memory of merged or tiled geometry, where adjacent triangles repeat the same vertices.
+func (td *TrianglesData) Compact(epsilon float64) *IndexedTriangles {
+	var (
+		vertices = make(TrianglesData, 0, td.Len())
+		indices  = make([]int, td.Len())
+		grid     = newNearGrid(epsilon)
+	)
+	for i, v := range *td {
+		index := grid.find(v.Position, func(j int) bool {
+			return vertices.vertexNear(j, td, i, epsilon)
+		})
+		if index < 0 {
+			index = len(vertices)
+			vertices = append(vertices, v)
+			grid.add(v.Position, index)
+		}
+		indices[i] = index
+	}
+
+	return &IndexedTriangles{
+		Vertices: &vertices,
+		Indices:  indices,
+	}
+}
+
+// nearGrid finds the items added before, whose positions are near a position. The items are
+// stored in a grid of cells of size epsilon, so only the items in the neighboring cells are
+// checked. If epsilon is 0 or less, only items at equal positions are checked.
+type nearGrid struct {
+	epsilon float64
+	cells   map[nearCell][]int
+}
+
+type nearCell struct {
+	x, y float64
+}
+
+func newNearGrid(epsilon float64) *nearGrid {
+	return &nearGrid{
+		epsilon: epsilon,
+		cells:   make(map[nearCell][]int),
+	}
+}
+
+func (g *nearGrid) cellOf(u Vec) nearCell {
+	if g.epsilon <= 0 {
+		return nearCell{u.X, u.Y}
+	}
+	return nearCell{math.Floor(u.X / g.epsilon), math.Floor(u.Y / g.epsilon)}
+}
+
+// add adds the item with the given index at the position u.
+func (g *nearGrid) add(u Vec, index int) {
+	c := g.cellOf(u)
+	g.cells[c] = append(g.cells[c], index)
+}
+
+// find returns the index of an added item in the cells around the position u, for which near
+// returns true, or -1 if there's no such item.
+func (g *nearGrid) find(u Vec, near func(index int) bool) int {
+	c := g.cellOf(u)
+	for dx := -1.0; dx <= 1; dx++ {
+		for dy := -1.0; dy <= 1; dy++ {
+			if g.epsilon <= 0 && (dx != 0 || dy != 0) {
+				continue
+			}
+			for _, index := range g.cells[nearCell{c.x + dx, c.y + dy}] {
+				if near(index) {
+					return index
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// BlendSeams replaces the color of vertices with positions equal within epsilon by the average of
+// their colors. Other properties are left untouched.
+//
+// This smooths the seams of stitched geometry, such as terrain or tiles, whose adjacent parts have
+// different colors along shared edges:
+//
+//   terrain := pixel.MergeTriangles(chunks...)
+//   terrain.BlendSeams(0.001)
+//
+// If the TrianglesData is used by a Drawer or a Batch, remember to call Dirty afterwards.
+func (td *TrianglesData) BlendSeams(epsilon float64) {
+	// each group of coincident vertices is represented by it's first vertex
+	var (
+		groups = make([]int, td.Len())
+		sums   = make(map[int]RGBA)
+		counts = make(map[int]int)
+		grid   = newNearGrid(epsilon)
+	)
+	for i, v := range *td {
+		group := grid.find(v.Position, func(j int) bool {
+			d := (*td)[j].Position.To(v.Position)
+			return math.Abs(d.X) <= epsilon && math.Abs(d.Y) <= epsilon
+		})
+		if group < 0 {
+			group = i
+			grid.add(v.Position, i)
+		}
+		groups[i] = group
+		sums[group] = sums[group].Add(v.Color)
+		counts[group]++
+	}
+
+	for i, group := range groups {
+		if counts[group] > 1 {
+			(*td)[i].Color = sums[group].Scaled(1 / float64(counts[group]))
+		}
+	}
+}
+
 // Position returns the position property of i-th vertex.
 func (td *TrianglesData) Position(i int) Vec {
 	return (*td)[i].Position
@@ -123,6 +606,245 @@ func (td *TrianglesData) Picture(i int) (pic Vec, intensity float64) {
 	return (*td)[i].Picture, (*td)[i].Intensity
 }
 
+// Layout of the vertices packed by TrianglesData.AppendFloat32. Each vertex takes Float32Stride
+// values, the offsets specify where each property starts within a vertex. This is the same layout
+// pixelgl uses for it's default vertex attributes.
+const (
+	Float32PositionOffset  = 0 // X, Y
+	Float32ColorOffset     = 2 // R, G, B, A
+	Float32PictureOffset   = 6 // X, Y
+	Float32IntensityOffset = 8 // Intensity
+	Float32Stride          = 9
+)
+
+// AppendFloat32 appends all vertices of the TrianglesData to dst as interleaved float32 values and
+// returns the extended slice. The layout of a vertex is described by the Float32 constants.
+//
+// Reusing dst between calls avoids allocations:
+//
+//   buf = tri.AppendFloat32(buf[:0])
+func (td *TrianglesData) AppendFloat32(dst []float32) []float32 {
+	for _, v := range *td {
+		dst = append(dst,
+			float32(v.Position.X), float32(v.Position.Y),
+			float32(v.Color.R), float32(v.Color.G), float32(v.Color.B), float32(v.Color.A),
+			float32(v.Picture.X), float32(v.Picture.Y),
+			float32(v.Intensity),
+		)
+	}
+	return dst
+}
+
+// TrianglesStats holds the sizes of TrianglesData returned by TrianglesData.Stats.
+//
+// Bytes is the approximate memory held by the vertices, including the unused capacity of the
+// underlying slice.
+type TrianglesStats struct {
+	Vertices  int
+	Triangles int
+	Bytes     int
+}
+
+// Stats returns the number of vertices and whole triangles of the TrianglesData and the approximate
+// memory they take. This is useful for profiling.
+func (td *TrianglesData) Stats() TrianglesStats {
+	// each vertex consists of 9 float64 values
+	const vertexBytes = 9 * 8
+	return TrianglesStats{
+		Vertices:  len(*td),
+		Triangles: len(*td) / 3,
+		Bytes:     cap(*td) * vertexBytes,
+	}
+}
+
+// Validate checks that the TrianglesData describe well-formed triangles and returns a descriptive
+// error of the first problem found, or nil. It checks that:
+//
+//   - the length is a multiple of three
+//   - positions and picture coordinates are finite
+//   - color components are within [0, 1] and RGB do not exceed alpha, since RGBA is premultiplied
+//   - picture intensities are within [0, 1]
+//
+// Picture coordinates are not checked against any bounds, because they're in the coordinates of
+// the Picture, which TrianglesData know nothing about. Validate is meant for debugging and tests.
+func (td *TrianglesData) Validate() error {
+	if len(*td)%3 != 0 {
+		return fmt.Errorf("(%T).Validate: length %d is not a multiple of three", td, len(*td))
+	}
+	finite := func(u Vec) bool {
+		return !math.IsNaN(u.X) && !math.IsInf(u.X, 0) && !math.IsNaN(u.Y) && !math.IsInf(u.Y, 0)
+	}
+	unit := func(x float64) bool {
+		return 0 <= x && x <= 1
+	}
+	for i, v := range *td {
+		if !finite(v.Position) {
+			return fmt.Errorf("(%T).Validate: vertex %d has invalid position %v", td, i, v.Position)
+		}
+		c := v.Color
+		if !unit(c.R) || !unit(c.G) || !unit(c.B) || !unit(c.A) {
+			return fmt.Errorf("(%T).Validate: vertex %d has color %v out of [0, 1]", td, i, c)
+		}
+		if c.R > c.A || c.G > c.A || c.B > c.A {
+			return fmt.Errorf("(%T).Validate: vertex %d has color %v not alpha-premultiplied", td, i, c)
+		}
+		if !finite(v.Picture) {
+			return fmt.Errorf("(%T).Validate: vertex %d has invalid picture coordinates %v", td, i, v.Picture)
+		}
+		if !unit(v.Intensity) {
+			return fmt.Errorf("(%T).Validate: vertex %d has intensity %v out of [0, 1]", td, i, v.Intensity)
+		}
+	}
+	return nil
+}
+
+// IndexedTriangles specifies a list of Triangles vertices, which share data through indices. The
+// i-th vertex of IndexedTriangles has the properties of Vertices[Indices[i]], so a vertex used by
+// multiple triangles is only stored once.
+//
+// IndexedTriangles supports TrianglesPosition, TrianglesColor and TrianglesPicture. Updating a
+// vertex changes all of the vertices that share it's index.
+//
+// The zero value is empty IndexedTriangles ready to use, Vertices are allocated when needed.
+type IndexedTriangles struct {
+	Vertices *TrianglesData
+	Indices  []int
+}
+
+// Len returns the number of vertices (indices) in IndexedTriangles.
+func (it *IndexedTriangles) Len() int {
+	return len(it.Indices)
+}
+
+// SetLen resizes IndexedTriangles to len, while keeping the original content.
+//
+// If len is greater than IndexedTriangles' current length, each new index refers to a new vertex
+// with default property values.
+func (it *IndexedTriangles) SetLen(len int) {
+	if it.Vertices == nil {
+		it.Vertices = &TrianglesData{}
+	}
+	if len > it.Len() {
+		needAppend := len - it.Len()
+		for i := 0; i < needAppend; i++ {
+			it.Indices = append(it.Indices, it.Vertices.Len())
+			*it.Vertices = append(*it.Vertices, zeroValueTriangleData)
+		}
+	}
+	if len < it.Len() {
+		it.Indices = it.Indices[:len]
+	}
+}
+
+// Slice returns a sub-Triangles of this IndexedTriangles, which shares the Vertices.
+func (it *IndexedTriangles) Slice(i, j int) Triangles {
+	return &IndexedTriangles{
+		Vertices: it.Vertices,
+		Indices:  it.Indices[i:j],
+	}
+}
+
+// Update copies vertex properties from the supplied Triangles into this IndexedTriangles.
+//
+// TrianglesPosition, TrianglesColor and TrianglesPicture are supported.
+func (it *IndexedTriangles) Update(t Triangles) {
+	if it.Len() != t.Len() {
+		panic(fmt.Errorf("(%T).Update: invalid triangles length", it))
+	}
+
+	// fast path optimization
+	if t, ok := t.(*TrianglesData); ok {
+		for i, index := range it.Indices {
+			(*it.Vertices)[index] = (*t)[i]
+		}
+		return
+	}
+
+	// slow path manual copy
+	if t, ok := t.(TrianglesPosition); ok {
+		for i, index := range it.Indices {
+			(*it.Vertices)[index].Position = t.Position(i)
+		}
+	}
+	if t, ok := t.(TrianglesColor); ok {
+		for i, index := range it.Indices {
+			(*it.Vertices)[index].Color = t.Color(i)
+		}
+	}
+	if t, ok := t.(TrianglesPicture); ok {
+		for i, index := range it.Indices {
+			(*it.Vertices)[index].Picture, (*it.Vertices)[index].Intensity = t.Picture(i)
+		}
+	}
+}
+
+// Copy returns an exact independent copy of this IndexedTriangles.
+func (it *IndexedTriangles) Copy() Triangles {
+	var vertices TrianglesData
+	if it.Vertices != nil {
+		vertices = append(vertices, *it.Vertices...)
+	}
+	return &IndexedTriangles{
+		Vertices: &vertices,
+		Indices:  append([]int(nil), it.Indices...),
+	}
+}
+
+// Position returns the position property of i-th vertex.
+func (it *IndexedTriangles) Position(i int) Vec {
+	return (*it.Vertices)[it.Indices[i]].Position
+}
+
+// Color returns the color property of i-th vertex.
+func (it *IndexedTriangles) Color(i int) RGBA {
+	return (*it.Vertices)[it.Indices[i]].Color
+}
+
+// Picture returns the picture property of i-th vertex.
+func (it *IndexedTriangles) Picture(i int) (pic Vec, intensity float64) {
+	return (*it.Vertices)[it.Indices[i]].Picture, (*it.Vertices)[it.Indices[i]].Intensity
+}
+
+// Flatten returns a new TrianglesData with the same vertices as this IndexedTriangles. Use it with
+// Targets, that don't handle IndexedTriangles efficiently.
+func (it *IndexedTriangles) Flatten() *TrianglesData {
+	td := make(TrianglesData, it.Len())
+	for i, index := range it.Indices {
+		td[i] = (*it.Vertices)[index]
+	}
+	return &td
+}
+
+// TrianglesDataPool is a pool of reusable TrianglesData. Use it to avoid allocating many
+// short-lived TrianglesData, such as a few new ones every frame.
+//
+// The zero value is an empty pool ready to use. TrianglesDataPool is safe for concurrent use.
+type TrianglesDataPool struct {
+	pool sync.Pool
+}
+
+// Get returns a TrianglesData of length len with all vertices initialized to default property
+// values. The TrianglesData is taken from the pool if possible, otherwise a new one is created.
+func (p *TrianglesDataPool) Get(len int) *TrianglesData {
+	td, ok := p.pool.Get().(*TrianglesData)
+	if !ok {
+		return MakeTrianglesData(len)
+	}
+	td.SetLen(0)
+	td.SetLen(len)
+	return td
+}
+
+// Put returns a TrianglesData to the pool for later reuse.
+//
+// The TrianglesData, as well as any Slice of it, must not be used after it's returned to the pool.
+func (p *TrianglesDataPool) Put(td *TrianglesData) {
+	if td == nil {
+		return
+	}
+	p.pool.Put(td)
+}
+
 // PictureData specifies an in-memory rectangular area of pixels and implements Picture and
 // PictureColor.
 //
@@ -167,9 +889,16 @@ func verticalFlip(rgba *image.RGBA) {
 	}
 }
 
-// PictureDataFromImage converts an image.Image into PictureData.
+// PictureDataFromImage converts an image.Image into PictureData. This is the way to use images
+// loaded by the image package, such as PNG or JPEG files, as Pictures:
+//
+//   pic := pixel.PictureDataFromImage(img)
+//   sprite := pixel.NewSprite(pic, pic.Bounds())
 //
 // The resulting PictureData's Bounds will be the equivalent of the supplied image.Image's Bounds.
+// Since the Y axis of image.Image points down and the Y axis of Pixel points up, the rows are
+// flipped, so the picture looks the same way up. The top row of the image is the top row of the
+// PictureData, the one just below it's Bounds' Max.Y.
 func PictureDataFromImage(img image.Image) *PictureData {
 	rgba := image.NewRGBA(img.Bounds())
 	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
@@ -227,9 +956,123 @@ func PictureDataFromPicture(pic Picture) *PictureData {
 	return pd
 }
 
+// ResizePicture resamples an arbitrary Picture into a new PictureData of the given size. The
+// resulting PictureData's Bounds start at the same position as the supplied Picture's Bounds.
+//
+// If smooth is false, the nearest pixel is used, which keeps pixel art crisp. Otherwise, the four
+// nearest pixels are bilinearly interpolated. Samples outside of the Picture are clamped to it's
+// edge pixels.
+func ResizePicture(pic Picture, size Vec, smooth bool) *PictureData {
+	src := PictureDataFromPicture(pic)
+	dst := MakePictureData(Rect{Min: src.Rect.Min, Max: src.Rect.Min.Add(size)})
+
+	srcW, dstW := src.Stride, dst.Stride
+	if srcW == 0 || dstW == 0 {
+		return dst
+	}
+	srcH, dstH := len(src.Pix)/srcW, len(dst.Pix)/dstW
+
+	clamp := func(x, max int) int {
+		if x < 0 {
+			return 0
+		}
+		if x > max {
+			return max
+		}
+		return x
+	}
+	at := func(x, y int) RGBA {
+		return fromColorRGBA(src.Pix[clamp(y, srcH-1)*srcW+clamp(x, srcW-1)])
+	}
+
+	for y := 0; y < dstH; y++ {
+		for x := 0; x < dstW; x++ {
+			// center of the destination pixel in the source pixels
+			sx := (float64(x) + 0.5) * float64(srcW) / float64(dstW)
+			sy := (float64(y) + 0.5) * float64(srcH) / float64(dstH)
+
+			if !smooth {
+				dst.Pix[y*dstW+x] = src.Pix[clamp(int(sx), srcW-1)+clamp(int(sy), srcH-1)*srcW]
+				continue
+			}
+
+			fx, fy := math.Floor(sx-0.5), math.Floor(sy-0.5)
+			tx, ty := sx-0.5-fx, sy-0.5-fy
+			x0, y0 := int(fx), int(fy)
+			bottom := LerpRGBA(at(x0, y0), at(x0+1, y0), tx)
+			top := LerpRGBA(at(x0, y0+1), at(x0+1, y0+1), tx)
+			dst.Pix[y*dstW+x] = toColorRGBA(LerpRGBA(bottom, top, ty))
+		}
+	}
+
+	return dst
+}
+
+// bayer4x4 is the threshold map of ordered dithering used by MapToPalette.
+var bayer4x4 = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// MapToPalette returns a copy of an arbitrary Picture as PictureData with the color of each pixel
+// replaced by the nearest color of the palette, measured as the Euclidean distance in RGB. This is
+// useful for retro looking graphics.
+//
+// Only the RGB of the palette colors is used, the alpha of each pixel is kept. If dither is true,
+// ordered dithering with a 4x4 Bayer matrix is used to approximate the colors missing in the
+// palette. The strength of the dithering is derived from the number of colors in the palette.
+//
+// An empty palette returns an error.
+func MapToPalette(pic Picture, palette []color.Color, dither bool) (*PictureData, error) {
+	if len(palette) == 0 {
+		return nil, fmt.Errorf("MapToPalette: empty palette")
+	}
+
+	// straight, not premultiplied, colors of the palette
+	colors := make([]RGBA, len(palette))
+	for i, c := range palette {
+		colors[i] = ToRGBA(c)
+		if colors[i].A > 0 {
+			colors[i] = colors[i].Scaled(1 / colors[i].A)
+		}
+	}
+	spread := 1 / math.Cbrt(float64(len(colors)))
+
+	src := PictureDataFromPicture(pic)
+	dst := MakePictureData(src.Rect)
+	for i, pix := range src.Pix {
+		if pix.A == 0 {
+			continue
+		}
+		c := fromColorRGBA(pix)
+		alpha := c.A
+		c = c.Scaled(1 / alpha)
+
+		if dither {
+			x, y := i%src.Stride, i/src.Stride
+			t := ((bayer4x4[y%4][x%4]+0.5)/16 - 0.5) * spread
+			c = c.Add(RGBA{t, t, t, 0})
+		}
+
+		best, bestDist := 0, math.Inf(1)
+		for j, p := range colors {
+			dr, dg, db := c.R-p.R, c.G-p.G, c.B-p.B
+			if dist := dr*dr + dg*dg + db*db; dist < bestDist {
+				best, bestDist = j, dist
+			}
+		}
+		dst.Pix[i] = toColorRGBA(RGB(colors[best].R, colors[best].G, colors[best].B).Scaled(alpha))
+	}
+
+	return dst, nil
+}
+
 // Image converts PictureData into an image.RGBA.
 //
-// The resulting image.RGBA's Bounds will be equivalent of the PictureData's Bounds.
+// The resulting image.RGBA's Bounds will be equivalent of the PictureData's Bounds. The rows are
+// flipped back, just like PictureDataFromImage flips them, so the image looks the same way up.
 func (pd *PictureData) Image() *image.RGBA {
 	bounds := image.Rect(
 		int(math.Floor(pd.Rect.Min.X)),
@@ -1,9 +1,14 @@
 package pixel_test
 
 import (
+	"encoding/json"
+	"image"
+	"image/color"
+	"math"
 	"testing"
 
 	"github.com/faiface/pixel"
+	"github.com/stretchr/testify/assert"
 )
 
 func BenchmarkMakeTrianglesData(b *testing.B) {
@@ -266,3 +271,912 @@ func BenchmarkTrianglesData_Picture(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkTrianglesData_BoundingCircle(b *testing.B) {
+	tests := []struct {
+		name string
+		len  int
+	}{
+		{
+			name: "Small ordered outline",
+			len:  100,
+		},
+		{
+			name: "Large ordered outline",
+			len:  30000,
+		},
+	}
+
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			outline := circleOutline(tt.len)
+			tData := pixel.MakeTrianglesData(len(outline))
+			for i, u := range outline {
+				(*tData)[i].Position = u
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = tData.BoundingCircle(true)
+			}
+		})
+	}
+}
+
+// circleOutline returns n positions in order around the circle with center (3, 4) and radius 10.
+func circleOutline(n int) []pixel.Vec {
+	outline := make([]pixel.Vec, n)
+	for i := range outline {
+		outline[i] = pixel.V(3, 4).Add(pixel.Unit(2 * math.Pi * float64(i) / float64(n)).Scaled(10))
+	}
+	return outline
+}
+
+// trianglesPosition is a Triangles implementation supporting only TrianglesPosition.
+type trianglesPosition []pixel.Vec
+
+func (tp *trianglesPosition) Len() int { return len(*tp) }
+
+func (tp *trianglesPosition) SetLen(len int) {
+	for len > tp.Len() {
+		*tp = append(*tp, pixel.ZV)
+	}
+	*tp = (*tp)[:len]
+}
+
+func (tp *trianglesPosition) Slice(i, j int) pixel.Triangles {
+	s := (*tp)[i:j]
+	return &s
+}
+
+func (tp *trianglesPosition) Update(t pixel.Triangles) {
+	if t, ok := t.(pixel.TrianglesPosition); ok {
+		for i := range *tp {
+			(*tp)[i] = t.Position(i)
+		}
+	}
+}
+
+func (tp *trianglesPosition) Copy() pixel.Triangles {
+	c := append(trianglesPosition(nil), *tp...)
+	return &c
+}
+
+func (tp *trianglesPosition) Position(i int) pixel.Vec { return (*tp)[i] }
+
+func TestTrianglesDataFromSlices(t *testing.T) {
+	pos := []pixel.Vec{pixel.V(1, 2), pixel.V(3, 4), pixel.V(5, 6)}
+	col := []pixel.RGBA{pixel.RGB(1, 0, 0), pixel.RGB(0, 1, 0), pixel.RGB(0, 0, 1)}
+	pic := []pixel.Vec{pixel.V(0, 0), pixel.V(8, 0), pixel.V(0, 8)}
+
+	tests := []struct {
+		name    string
+		col     []pixel.RGBA
+		pic     []pixel.Vec
+		wantErr bool
+	}{
+		{name: "All properties", col: col, pic: pic},
+		{name: "Positions only"},
+		{name: "Too few colors", col: col[:2], wantErr: true},
+		{name: "Too many picture coordinates", pic: append(pic, pixel.ZV), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pixel.TrianglesDataFromSlices(pos, tt.col, tt.pic)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TrianglesDataFromSlices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			for i := range pos {
+				want := pixel.MakeTrianglesData(1)
+				(*want)[0].Position = pos[i]
+				if tt.col != nil {
+					(*want)[0].Color = tt.col[i]
+				}
+				if tt.pic != nil {
+					(*want)[0].Picture = tt.pic[i]
+					(*want)[0].Intensity = 1
+				}
+				if (*got)[i] != (*want)[0] {
+					t.Errorf("vertex %d = %v, want %v", i, (*got)[i], (*want)[0])
+				}
+			}
+		})
+	}
+}
+
+func TestToTrianglesData(t *testing.T) {
+	t.Run("From TrianglesData", func(t *testing.T) {
+		src := pixel.MakeTrianglesData(3)
+		(*src)[1].Position = pixel.V(1, 2)
+		(*src)[1].Color = pixel.RGB(1, 0, 0)
+		(*src)[1].Picture = pixel.V(3, 4)
+		(*src)[1].Intensity = 0.5
+
+		got := pixel.ToTrianglesData(src)
+		if got == src {
+			t.Fatalf("ToTrianglesData returned the same TrianglesData, want a copy")
+		}
+		for i := range *src {
+			if (*got)[i] != (*src)[i] {
+				t.Errorf("vertex %d = %v, want %v", i, (*got)[i], (*src)[i])
+			}
+		}
+	})
+
+	t.Run("From position only", func(t *testing.T) {
+		src := &trianglesPosition{pixel.V(1, 2), pixel.V(3, 4), pixel.V(5, 6)}
+
+		got := pixel.ToTrianglesData(src)
+		if got.Len() != src.Len() {
+			t.Fatalf("Len() = %d, want %d", got.Len(), src.Len())
+		}
+		for i := range *src {
+			want := pixel.MakeTrianglesData(1)
+			(*want)[0].Position = (*src)[i]
+			if (*got)[i] != (*want)[0] {
+				t.Errorf("vertex %d = %v, want %v", i, (*got)[i], (*want)[0])
+			}
+		}
+	})
+}
+
+func TestTrianglesData_Append(t *testing.T) {
+	colored := pixel.MakeTrianglesData(3)
+	for i := range *colored {
+		(*colored)[i].Position = pixel.V(float64(i), 1)
+		(*colored)[i].Color = pixel.RGB(1, 0, 0)
+	}
+
+	tests := []struct {
+		name  string
+		tData *pixel.TrianglesData
+		t     pixel.Triangles
+	}{
+		{
+			name:  "Append TrianglesData",
+			tData: pixel.MakeTrianglesData(3),
+			t:     colored,
+		},
+		{
+			name:  "Append position only",
+			tData: pixel.MakeTrianglesData(3),
+			t:     &trianglesPosition{pixel.V(1, 2), pixel.V(3, 4), pixel.V(5, 6)},
+		},
+		{
+			name:  "Append empty",
+			tData: pixel.MakeTrianglesData(3),
+			t:     &pixel.TrianglesData{},
+		},
+		{
+			name:  "Append to empty",
+			tData: &pixel.TrianglesData{},
+			t:     colored,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldLen := tt.tData.Len()
+			tt.tData.Append(tt.t)
+
+			if tt.tData.Len() != oldLen+tt.t.Len() {
+				t.Fatalf("Len() = %d, want %d", tt.tData.Len(), oldLen+tt.t.Len())
+			}
+			for i := 0; i < tt.t.Len(); i++ {
+				want := pixel.MakeTrianglesData(1)
+				want.Update(tt.t.Slice(i, i+1))
+				if (*tt.tData)[oldLen+i] != (*want)[0] {
+					t.Errorf("vertex %d = %v, want %v", oldLen+i, (*tt.tData)[oldLen+i], (*want)[0])
+				}
+			}
+		})
+	}
+}
+
+func TestMergeTriangles(t *testing.T) {
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+	left := &pixel.TrianglesData{
+		{Position: pixel.V(0, 0), Color: red},
+		{Position: pixel.V(1, 0), Color: red},
+		{Position: pixel.V(1, 1), Color: red},
+	}
+	right := &trianglesPosition{pixel.V(1, 0), pixel.V(2, 0), pixel.V(1, 1)}
+
+	merged := pixel.MergeTriangles(left, right)
+	if merged.Len() != 6 {
+		t.Fatalf("MergeTriangles().Len() = %d, want 6", merged.Len())
+	}
+	if merged.Color(0) != red || merged.Color(3) != pixel.RGB(1, 1, 1) || merged.Position(4) != pixel.V(2, 0) {
+		t.Errorf("MergeTriangles() = %v, want a plain concatenation", *merged)
+	}
+	if empty := pixel.MergeTriangles(); empty.Len() != 0 {
+		t.Errorf("MergeTriangles() of nothing has length %d, want 0", empty.Len())
+	}
+
+	merged.SetAllColors(blue)
+	merged.Slice(0, 3).Update(left) // only the first part is red now
+	(*merged)[5].Position = pixel.V(1, 1.0001)
+	merged.BlendSeams(0.001)
+
+	purple := pixel.LerpRGBA(red, blue, 0.5)
+	for i, want := range []pixel.RGBA{red, purple, purple, purple, blue, purple} {
+		if got := merged.Color(i); !rgbaNear(got, want) {
+			t.Errorf("BlendSeams() vertex %d color = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestTrianglesData_Transform(t *testing.T) {
+	tData := pixel.MakeTrianglesData(3)
+	for i := range *tData {
+		(*tData)[i].Position = pixel.V(float64(i), 0)
+		(*tData)[i].Picture = pixel.V(float64(i), 1)
+	}
+
+	tData.Transform(pixel.IM.Moved(pixel.V(10, 20)))
+	tData.TransformColors(func(c pixel.RGBA) pixel.RGBA {
+		return c.Mul(pixel.RGB(1, 0, 0))
+	})
+
+	for i := range *tData {
+		want := pixel.MakeTrianglesData(1)
+		(*want)[0].Position = pixel.V(float64(i)+10, 20)
+		(*want)[0].Color = pixel.RGB(1, 0, 0)
+		(*want)[0].Picture = pixel.V(float64(i), 1)
+		if (*tData)[i] != (*want)[0] {
+			t.Errorf("vertex %d = %v, want %v", i, (*tData)[i], (*want)[0])
+		}
+	}
+}
+
+func TestTrianglesData_SetColor(t *testing.T) {
+	tData := pixel.MakeTrianglesData(3)
+
+	tData.SetAllColors(color.RGBA{R: 0xff, A: 0xff})
+	tData.SetColor(1, color.NRGBA{G: 0xff, A: 0x00})
+
+	want := []pixel.RGBA{pixel.RGB(1, 0, 0), {}, pixel.RGB(1, 0, 0)}
+	for i := range *tData {
+		if got := tData.Color(i); got != want[i] {
+			t.Errorf("color %d = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestTrianglesData_SetColorRange(t *testing.T) {
+	tData := pixel.MakeTrianglesData(6)
+	tData.SetAllColors(pixel.RGB(1, 0, 0))
+	tData.SetColorRange(3, 6, color.RGBA{B: 0xff, A: 0xff})
+	tData.SetColorRange(0, 0, color.White)
+
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+	want := []pixel.RGBA{red, red, red, blue, blue, blue}
+	for i := range *tData {
+		if got := tData.Color(i); got != want[i] {
+			t.Errorf("color %d = %v, want %v", i, got, want[i])
+		}
+	}
+
+	for _, r := range [][2]int{{-1, 2}, {4, 2}, {3, 7}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetColorRange(%d, %d) did not panic for length 6", r[0], r[1])
+				}
+			}()
+			tData.SetColorRange(r[0], r[1], color.White)
+		}()
+	}
+}
+
+func TestTrianglesData_SetAlpha(t *testing.T) {
+	tests := []struct {
+		name  string
+		color pixel.RGBA
+		fade  func(td *pixel.TrianglesData)
+		want  pixel.RGBA
+	}{
+		{
+			name:  "Half",
+			color: pixel.RGB(1, 0.5, 0),
+			fade:  func(td *pixel.TrianglesData) { td.SetAlpha(0.5) },
+			want:  pixel.RGBA{R: 0.5, G: 0.25, B: 0, A: 0.5},
+		},
+		{
+			name:  "Clamped alpha",
+			color: pixel.RGB(1, 0.5, 0),
+			fade:  func(td *pixel.TrianglesData) { td.SetAlpha(2) },
+			want:  pixel.RGB(1, 0.5, 0),
+		},
+		{
+			name:  "Clamped color",
+			color: pixel.RGBA{R: 2, G: 1, B: 0, A: 1},
+			fade:  func(td *pixel.TrianglesData) { td.SetAlpha(1) },
+			want:  pixel.RGB(1, 1, 0),
+		},
+		{
+			name:  "Fade",
+			color: pixel.RGB(1, 1, 1),
+			fade:  func(td *pixel.TrianglesData) { td.Fade(1, 0, 0.25) },
+			want:  pixel.Alpha(0.75),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tData := pixel.MakeTrianglesData(3)
+			tData.SetAllColors(tt.color)
+			tt.fade(tData)
+			for i := range *tData {
+				if got := tData.Color(i); got != tt.want {
+					t.Errorf("color %d = %v, want %v", i, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTrianglesData_ForEachTriangle(t *testing.T) {
+	var got [][3]int
+	pixel.MakeTrianglesData(6).ForEachTriangle(func(a, b, c int) {
+		got = append(got, [3]int{a, b, c})
+	})
+	want := [][3]int{{0, 1, 2}, {3, 4, 5}}
+	if len(got) != len(want) {
+		t.Fatalf("got %d triangles, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("triangle %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ForEachTriangle() did not panic on length 7")
+		}
+	}()
+	pixel.MakeTrianglesData(7).ForEachTriangle(func(a, b, c int) {})
+}
+
+func TestTrianglesData_Filter(t *testing.T) {
+	tData := pixel.MakeTrianglesData(10)
+	for i := range *tData {
+		(*tData)[i].Position = pixel.V(float64(i), 0)
+	}
+
+	got := tData.Filter(func(tri [3]int) bool {
+		return tri[0] != 3
+	})
+
+	want := []float64{0, 1, 2, 6, 7, 8}
+	if got.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", got.Len(), len(want))
+	}
+	for i := range *got {
+		if x := got.Position(i).X; x != want[i] {
+			t.Errorf("vertex %d comes from %v, want %v", i, x, want[i])
+		}
+	}
+}
+
+func TestTrianglesData_BoundingCircle(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []pixel.Vec
+		exact     bool
+		want      pixel.Circle
+	}{
+		{
+			name:      "Approximate",
+			positions: []pixel.Vec{pixel.V(0, 0), pixel.V(4, 0), pixel.V(0, 4)},
+			want:      pixel.C(pixel.V(2, 2), math.Sqrt(8)),
+		},
+		{
+			name:      "Exact right triangle",
+			positions: []pixel.Vec{pixel.V(0, 0), pixel.V(4, 0), pixel.V(0, 4)},
+			exact:     true,
+			want:      pixel.C(pixel.V(2, 2), math.Sqrt(8)),
+		},
+		{
+			name:      "Exact obtuse triangle",
+			positions: []pixel.Vec{pixel.V(0, 0), pixel.V(1, 1), pixel.V(10, 0)},
+			exact:     true,
+			want:      pixel.C(pixel.V(5, 0), 5),
+		},
+		{
+			name: "Exact equilateral triangle",
+			positions: []pixel.Vec{
+				pixel.V(0, 1), pixel.V(-math.Sqrt(3)/2, -0.5), pixel.V(math.Sqrt(3)/2, -0.5),
+				pixel.V(0, 0), pixel.V(0.1, 0.2), pixel.V(-0.3, 0.1),
+			},
+			exact: true,
+			want:  pixel.C(pixel.V(0, 0), 1),
+		},
+		{
+			name:      "Exact collinear",
+			positions: []pixel.Vec{pixel.V(2, 0), pixel.V(0, 0), pixel.V(6, 0)},
+			exact:     true,
+			want:      pixel.C(pixel.V(3, 0), 3),
+		},
+		{
+			name:      "Exact ordered outline",
+			positions: circleOutline(1000),
+			exact:     true,
+			want:      pixel.C(pixel.V(3, 4), 10),
+		},
+		{
+			name:      "Exact repeated positions",
+			positions: []pixel.Vec{pixel.V(1, 1), pixel.V(1, 1), pixel.V(3, 1), pixel.V(3, 1), pixel.V(1, 1)},
+			exact:     true,
+			want:      pixel.C(pixel.V(2, 1), 1),
+		},
+		{
+			name:  "Empty",
+			exact: true,
+			want:  pixel.Circle{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tData := pixel.MakeTrianglesData(len(tt.positions))
+			for i, u := range tt.positions {
+				(*tData)[i].Position = u
+			}
+			got := tData.BoundingCircle(tt.exact)
+			assert.InDelta(t, tt.want.Center.X, got.Center.X, 1e-9)
+			assert.InDelta(t, tt.want.Center.Y, got.Center.Y, 1e-9)
+			assert.InDelta(t, tt.want.Radius, got.Radius, 1e-9)
+		})
+	}
+}
+
+func TestTrianglesData_Subdivide(t *testing.T) {
+	tData := &pixel.TrianglesData{
+		{Position: pixel.V(0, 0), Color: pixel.RGB(1, 0, 0), Picture: pixel.V(0, 0), Intensity: 1},
+		{Position: pixel.V(4, 0), Color: pixel.RGB(0, 1, 0), Picture: pixel.V(8, 0), Intensity: 1},
+		{Position: pixel.V(0, 4), Color: pixel.RGB(0, 0, 1), Picture: pixel.V(0, 8), Intensity: 1},
+	}
+
+	if got := tData.Subdivide(0); !got.Equal(tData, 0) {
+		t.Errorf("Subdivide(0) = %v, want a copy", got)
+	}
+
+	for levels, want := range []int{3, 12, 48} {
+		got := tData.Subdivide(levels)
+		if got.Len() != want {
+			t.Errorf("Subdivide(%d).Len() = %d, want %d", levels, got.Len(), want)
+		}
+
+		area := 0.0
+		for i := 0; i < got.Len(); i += 3 {
+			a, b, c := got.Position(i), got.Position(i+1), got.Position(i+2)
+			area += a.To(b).Cross(a.To(c)) / 2
+		}
+		assert.InDelta(t, 8, area, 1e-9)
+	}
+
+	// the first vertex of the second triangle is the midpoint of the first edge
+	got := tData.Subdivide(1)
+	if v := (*got)[3]; v.Position != pixel.V(2, 0) || v.Color != pixel.RGB(0.5, 0.5, 0) || v.Picture != pixel.V(4, 0) {
+		t.Errorf("midpoint = %v, want position %v, color %v, picture %v", v, pixel.V(2, 0), pixel.RGB(0.5, 0.5, 0), pixel.V(4, 0))
+	}
+}
+
+func TestTrianglesData_Reverse(t *testing.T) {
+	tData := pixel.MakeTrianglesData(7)
+	for i := range *tData {
+		(*tData)[i].Position = pixel.V(float64(i), 0)
+	}
+
+	tData.Reverse()
+
+	want := []float64{0, 2, 1, 3, 5, 4, 6}
+	for i := range *tData {
+		if got := tData.Position(i).X; got != want[i] {
+			t.Errorf("vertex %d comes from %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestTrianglesData_Bounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []pixel.Vec
+		want      pixel.Rect
+	}{
+		{name: "Empty", positions: nil, want: pixel.Rect{}},
+		{name: "Single vertex", positions: []pixel.Vec{pixel.V(3, 4)}, want: pixel.R(3, 4, 3, 4)},
+		{
+			name:      "Triangle",
+			positions: []pixel.Vec{pixel.V(-2, 5), pixel.V(10, -1), pixel.V(4, 7)},
+			want:      pixel.R(-2, -1, 10, 7),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tData := pixel.MakeTrianglesData(len(tt.positions))
+			for i, pos := range tt.positions {
+				(*tData)[i].Position = pos
+			}
+			if got := tData.Bounds(); got != tt.want {
+				t.Errorf("TrianglesData.Bounds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrianglesData_Equal(t *testing.T) {
+	tData := pixel.MakeTrianglesData(3)
+	for i := range *tData {
+		(*tData)[i].Position = pixel.V(float64(i), 1)
+	}
+
+	nudged := tData.Copy().(*pixel.TrianglesData)
+	(*nudged)[1].Color.G -= 1e-6
+
+	recolored := tData.Copy().(*pixel.TrianglesData)
+	(*recolored)[2].Color = pixel.RGB(1, 0, 0)
+
+	tests := []struct {
+		name string
+		t    pixel.Triangles
+		want bool
+	}{
+		{name: "Same", t: tData.Copy(), want: true},
+		{name: "Within epsilon", t: nudged, want: true},
+		{name: "Different color", t: recolored, want: false},
+		{name: "Different length", t: pixel.MakeTrianglesData(6), want: false},
+		{name: "Position only", t: &trianglesPosition{pixel.V(0, 1), pixel.V(1, 1), pixel.V(2, 1)}, want: true},
+		{name: "Position only different", t: &trianglesPosition{pixel.V(0, 1), pixel.V(1, 1), pixel.V(2, 2)}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tData.Equal(tt.t, 1e-3); got != tt.want {
+				t.Errorf("TrianglesData.Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrianglesData_JSON(t *testing.T) {
+	tData := pixel.MakeTrianglesData(4)
+	(*tData)[1].Position = pixel.V(-1.5, 1e10)
+	(*tData)[2].Color = pixel.RGB(0.1, 0.2, 0.3).Mul(pixel.Alpha(0.4))
+	(*tData)[3].Picture = pixel.V(12.25, 7)
+	(*tData)[3].Intensity = 0.5
+
+	b, err := json.Marshal(tData)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	var got pixel.TrianglesData
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	if got.Len() != tData.Len() {
+		t.Fatalf("Len() = %d, want %d", got.Len(), tData.Len())
+	}
+	for i := range got {
+		if got[i] != (*tData)[i] {
+			t.Errorf("vertex %d = %v, want %v", i, got[i], (*tData)[i])
+		}
+	}
+}
+
+func TestTrianglesDataPool(t *testing.T) {
+	var pool pixel.TrianglesDataPool
+	want := pixel.MakeTrianglesData(1)
+
+	for _, len := range []int{10, 5, 20, 0} {
+		tData := pool.Get(len)
+		if tData.Len() != len {
+			t.Fatalf("Get(%d) returned TrianglesData of length %d", len, tData.Len())
+		}
+		for i := range *tData {
+			if (*tData)[i] != (*want)[0] {
+				t.Fatalf("Get(%d) returned vertex %d = %v, want %v", len, i, (*tData)[i], (*want)[0])
+			}
+			(*tData)[i].Position = pixel.V(1, 2)
+			(*tData)[i].Color = pixel.Alpha(0)
+		}
+		pool.Put(tData)
+	}
+}
+
+func TestTrianglesData_AppendFloat32(t *testing.T) {
+	tData := &pixel.TrianglesData{
+		{Position: pixel.V(1, 2), Color: pixel.RGBA{R: 0.1, G: 0.2, B: 0.3, A: 0.4}, Picture: pixel.V(5, 6), Intensity: 0.5},
+		{Position: pixel.V(-1, -2), Color: pixel.Alpha(1), Picture: pixel.V(7, 8), Intensity: 1},
+	}
+	prefix := []float32{42}
+
+	got := tData.AppendFloat32(prefix)
+	if len(got) != 1+2*pixel.Float32Stride {
+		t.Fatalf("len = %d, want %d", len(got), 1+2*pixel.Float32Stride)
+	}
+	if got[0] != 42 {
+		t.Errorf("dst content overwritten")
+	}
+
+	second := got[1+pixel.Float32Stride:]
+	checks := []struct {
+		name string
+		got  float32
+		want float32
+	}{
+		{name: "Position", got: second[pixel.Float32PositionOffset+1], want: -2},
+		{name: "Color", got: second[pixel.Float32ColorOffset+3], want: 1},
+		{name: "Picture", got: second[pixel.Float32PictureOffset], want: 7},
+		{name: "Intensity", got: second[pixel.Float32IntensityOffset], want: 1},
+		{name: "First color", got: got[1+pixel.Float32ColorOffset+2], want: float32(0.3)},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestTrianglesData_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(td *pixel.TrianglesData)
+		valid  bool
+	}{
+		{name: "Valid", modify: func(td *pixel.TrianglesData) {}, valid: true},
+		{name: "Length", modify: func(td *pixel.TrianglesData) { td.SetLen(4) }},
+		{name: "NaN position", modify: func(td *pixel.TrianglesData) { (*td)[1].Position.X = math.NaN() }},
+		{name: "Color out of range", modify: func(td *pixel.TrianglesData) { (*td)[2].Color.A = 1.5 }},
+		{name: "Not premultiplied", modify: func(td *pixel.TrianglesData) { (*td)[0].Color = pixel.RGBA{R: 1, A: 0.5} }},
+		{name: "Infinite picture", modify: func(td *pixel.TrianglesData) { (*td)[0].Picture.Y = math.Inf(1) }},
+		{name: "Intensity out of range", modify: func(td *pixel.TrianglesData) { (*td)[0].Intensity = -1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := pixel.MakeTrianglesData(3)
+			tt.modify(td)
+			if err := td.Validate(); (err == nil) != tt.valid {
+				t.Errorf("TrianglesData.Validate() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestTrianglesData_Stats(t *testing.T) {
+	td := pixel.MakeTrianglesData(7)
+	got := td.Stats()
+	want := pixel.TrianglesStats{Vertices: 7, Triangles: 2, Bytes: 7 * 72}
+	if got != want {
+		t.Errorf("TrianglesData.Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestIndexedTriangles(t *testing.T) {
+	vertices := pixel.MakeTrianglesData(4)
+	for i, pos := range []pixel.Vec{pixel.V(0, 0), pixel.V(1, 0), pixel.V(1, 1), pixel.V(0, 1)} {
+		(*vertices)[i].Position = pos
+	}
+	square := &pixel.IndexedTriangles{
+		Vertices: vertices,
+		Indices:  []int{0, 1, 2, 0, 2, 3},
+	}
+
+	flat := square.Flatten()
+	if flat.Len() != square.Len() {
+		t.Fatalf("Flatten().Len() = %d, want %d", flat.Len(), square.Len())
+	}
+	for i := 0; i < square.Len(); i++ {
+		if flat.Position(i) != square.Position(i) {
+			t.Errorf("Flatten().Position(%d) = %v, want %v", i, flat.Position(i), square.Position(i))
+		}
+	}
+
+	// updating a shared vertex through a slice changes all of its uses
+	recolored := pixel.MakeTrianglesData(3)
+	for i := range *recolored {
+		(*recolored)[i].Position = square.Position(3 + i)
+		(*recolored)[i].Color = pixel.RGB(1, 0, 0)
+	}
+	square.Slice(3, 6).Update(recolored)
+	for i, want := range []pixel.RGBA{pixel.RGB(1, 0, 0), pixel.Alpha(1), pixel.RGB(1, 0, 0)} {
+		if got := square.Color(i); got != want {
+			t.Errorf("Color(%d) = %v, want %v", i, got, want)
+		}
+	}
+
+	copied := square.Copy().(*pixel.IndexedTriangles)
+	square.SetLen(9)
+	if square.Len() != 9 || vertices.Len() != 7 {
+		t.Fatalf("after SetLen(9): Len() = %d, Vertices.Len() = %d, want 9 and 7", square.Len(), vertices.Len())
+	}
+	if copied.Len() != 6 || copied.Vertices.Len() != 4 {
+		t.Errorf("Copy changed with the original: Len() = %d, Vertices.Len() = %d", copied.Len(), copied.Vertices.Len())
+	}
+	square.SetLen(3)
+	if square.Len() != 3 {
+		t.Errorf("after SetLen(3): Len() = %d, want 3", square.Len())
+	}
+}
+
+func TestTrianglesData_Compact(t *testing.T) {
+	// two triangles of a square, the shared vertices differ slightly in the second one
+	tData := pixel.MakeTrianglesData(6)
+	for i, pos := range []pixel.Vec{
+		pixel.V(0, 0), pixel.V(1, 0), pixel.V(1, 1),
+		pixel.V(0, 1e-4), pixel.V(1, 1-1e-4), pixel.V(0, 1),
+	} {
+		(*tData)[i].Position = pos
+	}
+
+	tests := []struct {
+		name         string
+		epsilon      float64
+		wantVertices int
+	}{
+		{name: "Exact", epsilon: 0, wantVertices: 6},
+		{name: "Within epsilon", epsilon: 1e-3, wantVertices: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tData.Compact(tt.epsilon)
+			if got.Vertices.Len() != tt.wantVertices {
+				t.Errorf("Compact().Vertices.Len() = %d, want %d", got.Vertices.Len(), tt.wantVertices)
+			}
+			if !tData.Equal(got, tt.epsilon) {
+				t.Errorf("Compact() doesn't equal the original within %v", tt.epsilon)
+			}
+		})
+	}
+
+	// vertices at the same position with different colors are not shared
+	tData.SetColor(3, pixel.RGB(1, 0, 0))
+	if got := tData.Compact(1e-3); got.Vertices.Len() != 5 {
+		t.Errorf("Compact().Vertices.Len() with different colors = %d, want 5", got.Vertices.Len())
+	}
+}
+
+func TestPictureDataFromImage(t *testing.T) {
+	red := color.NRGBA{R: 0xff, A: 0xff}
+	blue := color.NRGBA{B: 0xff, A: 0x80}
+	img := image.NewNRGBA(image.Rect(1, 2, 4, 4))
+	img.Set(1, 2, red)  // top left
+	img.Set(3, 3, blue) // bottom right
+
+	pic := pixel.PictureDataFromImage(img)
+	if want := pixel.R(1, 2, 4, 4); pic.Bounds() != want {
+		t.Fatalf("Bounds() = %v, want %v", pic.Bounds(), want)
+	}
+	tests := []struct {
+		name string
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{name: "Top left", at: pixel.V(1.5, 3.5), want: pixel.ToRGBA(red)},
+		{name: "Bottom right", at: pixel.V(3.5, 2.5), want: pixel.ToRGBA(blue)},
+		{name: "Empty", at: pixel.V(1.5, 2.5), want: pixel.Alpha(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pic.Color(tt.at); !rgbaNear(got, tt.want) {
+				t.Errorf("Color(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+
+	back := pic.Image()
+	if back.Bounds() != img.Bounds() {
+		t.Fatalf("Image().Bounds() = %v, want %v", back.Bounds(), img.Bounds())
+	}
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			if got, want := pixel.ToRGBA(back.At(x, y)), pixel.ToRGBA(img.At(x, y)); !rgbaNear(got, want) {
+				t.Errorf("Image().At(%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestIndexedTriangles_ZeroValue(t *testing.T) {
+	var it pixel.IndexedTriangles
+	if c := it.Copy(); c.Len() != 0 {
+		t.Errorf("Copy().Len() of zero IndexedTriangles = %d, want 0", c.Len())
+	}
+
+	it.SetLen(3)
+	if it.Len() != 3 || it.Vertices.Len() != 3 {
+		t.Fatalf("SetLen(3) gives %d indices and %d vertices, want 3 and 3", it.Len(), it.Vertices.Len())
+	}
+	if got := it.Color(2); got != pixel.Alpha(1) {
+		t.Errorf("Color(2) = %v, want %v", got, pixel.Alpha(1))
+	}
+}
+
+func TestResizePicture(t *testing.T) {
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+	pic := pixel.MakePictureData(pixel.R(10, 10, 12, 11))
+	pic.Pix[pic.Index(pixel.V(10, 10))] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(11, 10))] = color.RGBA{B: 0xff, A: 0xff}
+
+	tests := []struct {
+		name   string
+		size   pixel.Vec
+		smooth bool
+		want   []pixel.RGBA
+	}{
+		{name: "Nearest up", size: pixel.V(4, 1), want: []pixel.RGBA{red, red, blue, blue}},
+		{
+			name:   "Smooth up",
+			size:   pixel.V(4, 1),
+			smooth: true,
+			want:   []pixel.RGBA{red, pixel.LerpRGBA(red, blue, 0.25), pixel.LerpRGBA(red, blue, 0.75), blue},
+		},
+		{name: "Nearest down", size: pixel.V(1, 1), want: []pixel.RGBA{blue}},
+		{name: "Smooth down", size: pixel.V(1, 1), smooth: true, want: []pixel.RGBA{pixel.LerpRGBA(red, blue, 0.5)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.ResizePicture(pic, tt.size, tt.smooth)
+			if want := pixel.R(10, 10, 10+tt.size.X, 10+tt.size.Y); got.Bounds() != want {
+				t.Fatalf("Bounds() = %v, want %v", got.Bounds(), want)
+			}
+			for i, want := range tt.want {
+				at := pixel.V(10.5+float64(i), 10.5)
+				c := got.Color(at)
+				d := c.Sub(want)
+				for _, x := range []float64{d.R, d.G, d.B, d.A} {
+					if x < -1.0/255 || x > 1.0/255 {
+						t.Errorf("Color(%v) = %v, want %v", at, c, want)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMapToPalette(t *testing.T) {
+	palette := []color.Color{pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1), pixel.RGB(0, 0, 0)}
+	pic := pixel.MakePictureData(pixel.R(0, 0, 3, 1))
+	pic.Pix[0] = color.RGBA{R: 0xcc, G: 0x1a, B: 0x1a, A: 0xff}
+	pic.Pix[1] = color.RGBA{B: 0x73, A: 0x80} // half transparent blue
+	pic.Pix[2] = color.RGBA{}
+
+	got, err := pixel.MapToPalette(pic, palette, false)
+	if err != nil {
+		t.Fatalf("MapToPalette() error = %v", err)
+	}
+	for i, want := range []pixel.RGBA{pixel.RGB(1, 0, 0), {B: 0x80 / 255.0, A: 0x80 / 255.0}, {}} {
+		if c := got.Color(pixel.V(float64(i)+0.5, 0.5)); !rgbaNear(c, want) {
+			t.Errorf("MapToPalette() pixel %d = %v, want %v", i, c, want)
+		}
+	}
+
+	// ordered dithering of mid gray by black and white makes half of the pixels white
+	gray := pixel.MakePictureData(pixel.R(0, 0, 4, 4))
+	for i := range gray.Pix {
+		gray.Pix[i] = color.RGBA{0x80, 0x80, 0x80, 0xff}
+	}
+	for _, tt := range []struct {
+		dither bool
+		white  int
+	}{{false, 16}, {true, 8}} {
+		got, _ := pixel.MapToPalette(gray, []color.Color{pixel.RGB(0, 0, 0), pixel.RGB(1, 1, 1)}, tt.dither)
+		white := 0
+		for _, c := range got.Pix {
+			if c.R == 0xff {
+				white++
+			}
+		}
+		if white != tt.white {
+			t.Errorf("MapToPalette() with dither %v has %d white pixels, want %d", tt.dither, white, tt.white)
+		}
+	}
+
+	if _, err := pixel.MapToPalette(pic, nil, false); err == nil {
+		t.Error("MapToPalette() with an empty palette did not return an error")
+	}
+}
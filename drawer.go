@@ -16,6 +16,10 @@ package pixel
 // Whenever you change the Triangles, call Dirty to notify Drawer that Triangles changed. You don't
 // need to notify Drawer about a change of the Picture.
 //
+// Drawer caches the Triangles made by each Target it's drawn to. The cached Triangles are only
+// updated when the Drawer is dirty, which happens lazily in the next Draw onto that Target, or
+// eagerly in Flush. Drawing unchanged Triangles over and over costs no additional updates.
+//
 // Note, that Drawer caches the results of MakePicture from Targets it's drawn to for each Picture
 // it's set to. What it means is that using a Drawer with an unbounded number of Pictures leads to a
 // memory leak, since Drawer caches them and never forgets. In such a situation, create a new Drawer
@@ -28,6 +32,8 @@ type Drawer struct {
 	inited  bool
 }
 
+var _ Drawable = (*Drawer)(nil)
+
 type drawerTarget struct {
 	tris  TargetTriangles
 	pics  map[Picture]TargetPicture
@@ -51,6 +57,51 @@ func (d *Drawer) Dirty() {
 	}
 }
 
+// SetTriangles sets the Triangles of this Drawer and marks it dirty. This way, one Drawer can be
+// shared to draw many different Triangles one after another, instead of allocating a Drawer and
+// it's cached Target data for each of them:
+//
+//   for _, shape := range shapes {
+//   	d.SetTriangles(shape)
+//   	d.Draw(target)
+//   }
+//
+// Each switch updates the whole cached Triangles of the next Target drawn to, so this saves memory
+// at the cost of updates. The cached Triangles are made from the first Triangles drawn, so all the
+// shared Triangles should support the same properties. A shared Drawer, just like any Drawer, must
+// not be used from multiple goroutines at once.
+func (d *Drawer) SetTriangles(t Triangles) {
+	d.Triangles = t
+	d.Dirty()
+}
+
+// Flush immediately updates the cached Triangles of all Targets this Drawer has been drawn to, if
+// the Drawer is dirty. Otherwise, the update happens lazily in the next Draw onto each Target.
+//
+// To force an update of unchanged Triangles, call Dirty before Flush.
+func (d *Drawer) Flush() {
+	d.lazyInit()
+
+	if d.Triangles == nil {
+		return
+	}
+
+	for _, dt := range d.targets {
+		dt.update(d.Triangles)
+	}
+}
+
+// Clean returns whether the cached Triangles of all Targets this Drawer has been drawn to are up to
+// date, that is, whether there are no changes waiting for the next Draw or Flush.
+func (d *Drawer) Clean() bool {
+	for _, dt := range d.targets {
+		if dt.tris != nil && !dt.clean {
+			return false
+		}
+	}
+	return true
+}
+
 // Draw efficiently draws Triangles with Picture onto the provided Target.
 //
 // If Triangles is nil, nothing will be drawn. If Picture is nil, Triangles will be drawn without a
@@ -75,11 +126,7 @@ func (d *Drawer) Draw(t Target) {
 		dt.clean = true
 	}
 
-	if !dt.clean {
-		dt.tris.SetLen(d.Triangles.Len())
-		dt.tris.Update(d.Triangles)
-		dt.clean = true
-	}
+	dt.update(d.Triangles)
 
 	if d.Picture == nil {
 		dt.tris.Draw()
@@ -94,3 +141,12 @@ func (d *Drawer) Draw(t Target) {
 
 	pic.Draw(dt.tris)
 }
+
+func (dt *drawerTarget) update(t Triangles) {
+	if dt.tris == nil || dt.clean {
+		return
+	}
+	dt.tris.SetLen(t.Len())
+	dt.tris.Update(t)
+	dt.clean = true
+}
@@ -16,3 +16,65 @@ func BenchmarkSpriteDrawBatch(b *testing.B) {
 		sprite.Draw(batch, pixel.IM)
 	}
 }
+
+func TestDrawer_Flush(t *testing.T) {
+	tris := &pixel.TrianglesData{
+		{Position: pixel.V(0, 0), Color: pixel.RGB(1, 1, 1)},
+		{Position: pixel.V(2, 0), Color: pixel.RGB(1, 1, 1)},
+		{Position: pixel.V(0, 2), Color: pixel.RGB(1, 1, 1)},
+	}
+	it := pixel.NewImageTarget(pixel.R(0, 0, 1, 1))
+	d := pixel.Drawer{Triangles: tris}
+
+	if !d.Clean() {
+		t.Errorf("new Drawer is not clean")
+	}
+
+	d.Draw(it)
+	tris.SetAllColors(pixel.RGB(1, 0, 0))
+	d.Dirty()
+	if d.Clean() {
+		t.Errorf("Drawer is clean after Dirty")
+	}
+
+	d.Flush()
+	if !d.Clean() {
+		t.Errorf("Drawer is not clean after Flush")
+	}
+
+	it.Clear(pixel.RGBA{})
+	d.Draw(it)
+	if got := it.Color(pixel.V(0.5, 0.5)); got != pixel.RGB(1, 0, 0) {
+		t.Errorf("drew %v, want %v", got, pixel.RGB(1, 0, 0))
+	}
+}
+
+func TestDrawer_SetTriangles(t *testing.T) {
+	quad := func(x float64, c pixel.RGBA) *pixel.TrianglesData {
+		tris := &pixel.TrianglesData{
+			{Position: pixel.V(x, 0)}, {Position: pixel.V(x+1, 0)}, {Position: pixel.V(x+1, 1)},
+			{Position: pixel.V(x, 0)}, {Position: pixel.V(x+1, 1)}, {Position: pixel.V(x, 1)},
+		}
+		tris.SetAllColors(c)
+		return tris
+	}
+	shapes := []*pixel.TrianglesData{
+		quad(0, pixel.RGB(1, 0, 0)),
+		quad(1, pixel.RGB(0, 1, 0)),
+		quad(2, pixel.RGB(0, 0, 1)),
+	}
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 3, 1))
+	var d pixel.Drawer
+	for _, shape := range shapes {
+		d.SetTriangles(shape)
+		d.Draw(it)
+	}
+
+	for i, shape := range shapes {
+		at := pixel.V(float64(i)+0.5, 0.5)
+		if got := it.Color(at); got != shape.Color(0) {
+			t.Errorf("Color(%v) = %v, want %v", at, got, shape.Color(0))
+		}
+	}
+}
@@ -3,6 +3,9 @@ package pixel
 import (
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // Clamp returns x clamped to the interval [min, max].
@@ -81,6 +84,17 @@ func Unit(angle float64) Vec {
 	return Vec{1, 0}.Rotated(angle)
 }
 
+// Polar returns a vector of the given length facing the given angle. It's the inverse of Len and
+// Angle, which is handy for radial layouts and orbits:
+//
+//   for i := range items {
+//   	pos := center.Add(pixel.Polar(radius, 2*math.Pi*float64(i)/float64(len(items))))
+//   	// ...
+//   }
+func Polar(length, angle float64) Vec {
+	return Unit(angle).Scaled(length)
+}
+
 // String returns the string representation of the vector u.
 //
 //   u := pixel.V(4.5, -1.3)
@@ -90,6 +104,30 @@ func (u Vec) String() string {
 	return fmt.Sprintf("Vec(%v, %v)", u.X, u.Y)
 }
 
+// ParseVec parses a vector from the string representation returned by String. Surrounding
+// whitespace is ignored.
+//
+//   u, err := pixel.ParseVec(" Vec(4.5, -1.3) ") // u is Vec(4.5, -1.3), err is nil
+func ParseVec(s string) (Vec, error) {
+	str := strings.TrimSpace(s)
+	if !strings.HasPrefix(str, "Vec(") || !strings.HasSuffix(str, ")") {
+		return ZV, fmt.Errorf("ParseVec: %q is not of form Vec(x, y)", s)
+	}
+	coords := strings.Split(str[len("Vec("):len(str)-len(")")], ",")
+	if len(coords) != 2 {
+		return ZV, fmt.Errorf("ParseVec: %q does not have exactly two coordinates", s)
+	}
+	x, err := strconv.ParseFloat(strings.TrimSpace(coords[0]), 64)
+	if err != nil {
+		return ZV, fmt.Errorf("ParseVec: invalid x coordinate in %q: %v", s, err)
+	}
+	y, err := strconv.ParseFloat(strings.TrimSpace(coords[1]), 64)
+	if err != nil {
+		return ZV, fmt.Errorf("ParseVec: invalid y coordinate in %q: %v", s, err)
+	}
+	return Vec{x, y}, nil
+}
+
 // XY returns the components of the vector in two return values.
 func (u Vec) XY() (x, y float64) {
 	return u.X, u.Y
@@ -119,6 +157,55 @@ func (u Vec) Floor() Vec {
 	}
 }
 
+// Ceil rounds x and y up to their integer equivalents.
+func (u Vec) Ceil() Vec {
+	return Vec{
+		math.Ceil(u.X),
+		math.Ceil(u.Y),
+	}
+}
+
+// Snapped rounds x and y to the nearest multiple of the corresponding component of grid. Halfway
+// values are rounded up. A zero component of grid leaves that component unchanged.
+func (u Vec) Snapped(grid Vec) Vec {
+	return Vec{
+		snap(u.X, grid.X, 0.5),
+		snap(u.Y, grid.Y, 0.5),
+	}
+}
+
+// SnappedFloor rounds x and y down to a multiple of the corresponding component of grid. A zero
+// component of grid leaves that component unchanged.
+func (u Vec) SnappedFloor(grid Vec) Vec {
+	return Vec{
+		snap(u.X, grid.X, 0),
+		snap(u.Y, grid.Y, 0),
+	}
+}
+
+func snap(x, grid, offset float64) float64 {
+	if grid == 0 {
+		return x
+	}
+	return math.Floor(x/grid+offset) * grid
+}
+
+// Min returns the component-wise minimum of vectors u and v.
+func (u Vec) Min(v Vec) Vec {
+	return Vec{
+		math.Min(u.X, v.X),
+		math.Min(u.Y, v.Y),
+	}
+}
+
+// Max returns the component-wise maximum of vectors u and v.
+func (u Vec) Max(v Vec) Vec {
+	return Vec{
+		math.Max(u.X, v.X),
+		math.Max(u.Y, v.Y),
+	}
+}
+
 // To returns the vector from u to v. Equivalent to v.Sub(u).
 func (u Vec) To(v Vec) Vec {
 	return Vec{
@@ -142,12 +229,25 @@ func (u Vec) Len() float64 {
 	return math.Hypot(u.X, u.Y)
 }
 
+// SqLen returns the squared length of the vector u. It's faster than Len, so prefer it for
+// comparing lengths.
+func (u Vec) SqLen() float64 {
+	return u.X*u.X + u.Y*u.Y
+}
+
 // Angle returns the angle between the vector u and the x-axis. The result is in range [-Pi, Pi].
+//
+// The angle of the zero vector is 0, including the zero vector with negative zero components.
 func (u Vec) Angle() float64 {
+	if u.X == 0 && u.Y == 0 {
+		return 0
+	}
 	return math.Atan2(u.Y, u.X)
 }
 
 // Unit returns a vector of length 1 facing the direction of u (has the same angle).
+//
+// The zero vector has no direction, so Unit returns V(1, 0) for it, which has the same angle.
 func (u Vec) Unit() Vec {
 	if u.X == 0 && u.Y == 0 {
 		return Vec{1, 0}
@@ -181,8 +281,11 @@ func (u Vec) Cross(v Vec) float64 {
 
 // Project returns a projection (or component) of vector u in the direction of vector v.
 //
-// Behaviour is undefined if v is a zero vector.
+// If v is the zero vector, the zero vector is returned.
 func (u Vec) Project(v Vec) Vec {
+	if v.X == 0 && v.Y == 0 {
+		return ZV
+	}
 	len := u.Dot(v) / v.Len()
 	return v.Unit().Scaled(len)
 }
@@ -208,6 +311,114 @@ func Lerp(a, b Vec, t float64) Vec {
 	return a.Scaled(1 - t).Add(b.Scaled(t))
 }
 
+// QuadraticBezier returns points along the quadratic Bezier curve from p0 to p2 with the control
+// point p1. The curve is split into the given number of segments of equal parameter length, so
+// segments+1 points are returned, starting with p0 and ending with p2.
+//
+// The points can be Pushed to an IMDraw to draw the curve as a line. If segments is less than 1,
+// one segment is used.
+func QuadraticBezier(p0, p1, p2 Vec, segments int) []Vec {
+	if segments < 1 {
+		segments = 1
+	}
+	points := make([]Vec, segments+1)
+	for i := range points {
+		t := float64(i) / float64(segments)
+		points[i] = Lerp(Lerp(p0, p1, t), Lerp(p1, p2, t), t)
+	}
+	return points
+}
+
+// CubicBezier returns points along the cubic Bezier curve from p0 to p3 with the control points p1
+// and p2. The curve is split into the given number of segments of equal parameter length, so
+// segments+1 points are returned, starting with p0 and ending with p3.
+//
+// The points can be Pushed to an IMDraw to draw the curve as a line. If segments is less than 1,
+// one segment is used.
+func CubicBezier(p0, p1, p2, p3 Vec, segments int) []Vec {
+	if segments < 1 {
+		segments = 1
+	}
+	points := make([]Vec, segments+1)
+	for i := range points {
+		t := float64(i) / float64(segments)
+		a, b, c := Lerp(p0, p1, t), Lerp(p1, p2, t), Lerp(p2, p3, t)
+		points[i] = Lerp(Lerp(a, b, t), Lerp(b, c, t), t)
+	}
+	return points
+}
+
+// ArcPoints returns points along the arc of the circle with the given center and radius, from the
+// angle from to the angle to in radians. The arc is split into the given number of segments, so
+// segments+1 points are returned. If from<to, the arc goes counterclockwise, otherwise clockwise,
+// just like in IMDraw.CircleArc. The angles are not normalized, so a range of 2*Pi gives a full
+// circle, where the last point equals the first one.
+//
+// The points can be Pushed to an IMDraw to draw the arc as a line. If segments is less than 1, one
+// segment is used.
+func ArcPoints(center Vec, radius, from, to float64, segments int) []Vec {
+	if segments < 1 {
+		segments = 1
+	}
+	points := make([]Vec, segments+1)
+	for i := range points {
+		angle := from + (to-from)*float64(i)/float64(segments)
+		points[i] = center.Add(Unit(angle).Scaled(radius))
+	}
+	return points
+}
+
+// PieSlice returns a Polygon of the circular sector with the given center and radius, spanning the
+// arc from the angle from to the angle to, as returned by ArcPoints. The first vertex of the Polygon
+// is the center, followed by the points of the arc.
+//
+// To draw the slice filled, Push it's vertices to an IMDraw and draw a Polygon, or use
+// IMDraw.CircleArc with zero thickness, which fills the same fan of triangles.
+func PieSlice(center Vec, radius, from, to float64, segments int) Polygon {
+	return append(Polygon{center}, ArcPoints(center, radius, from, to, segments)...)
+}
+
+// CatmullRom returns points along the Catmull-Rom spline passing through all of the supplied points.
+// Each span between two consecutive points is split into the given number of segments of equal
+// parameter length, so (len(points)-1)*segmentsPerSpan+1 points are returned, starting with the
+// first and ending with the last of the supplied points.
+//
+// The first and the last point are duplicated to compute the tangents at the ends of the spline.
+// Fewer than two points are returned unchanged. If segmentsPerSpan is less than 1, one segment is
+// used.
+func CatmullRom(points []Vec, segmentsPerSpan int) []Vec {
+	if len(points) < 2 {
+		return points
+	}
+	if segmentsPerSpan < 1 {
+		segmentsPerSpan = 1
+	}
+	at := func(i int) Vec {
+		if i < 0 {
+			i = 0
+		}
+		if i >= len(points) {
+			i = len(points) - 1
+		}
+		return points[i]
+	}
+
+	spline := make([]Vec, 0, (len(points)-1)*segmentsPerSpan+1)
+	for i := 0; i+1 < len(points); i++ {
+		p0, p1, p2, p3 := at(i-1), at(i), at(i+1), at(i+2)
+		for j := 0; j < segmentsPerSpan; j++ {
+			t := float64(j) / float64(segmentsPerSpan)
+			t2, t3 := t*t, t*t*t
+			spline = append(spline, p1.Scaled(2).
+				Add(p2.Sub(p0).Scaled(t)).
+				Add(p0.Scaled(2).Sub(p1.Scaled(5)).Add(p2.Scaled(4)).Sub(p3).Scaled(t2)).
+				Add(p1.Sub(p2).Scaled(3).Add(p3).Sub(p0).Scaled(t3)).
+				Scaled(0.5))
+		}
+	}
+	return append(spline, points[len(points)-1])
+}
+
 // Line is a 2D line segment, between points A and B.
 type Line struct {
 	A, B Vec
@@ -532,6 +743,13 @@ func (r Rect) Area() float64 {
 	return r.W() * r.H()
 }
 
+// Empty returns whether r contains no area, such as the result of Intersect of two Rects that don't
+// overlap. A Rect, whose Min is not below and to the left of it's Max, such as R(10, 10, 0, 0), is
+// empty, just like Contains reports no point inside of it. Use Norm to get a non-empty Rect from it.
+func (r Rect) Empty() bool {
+	return r.W() <= 0 || r.H() <= 0
+}
+
 // Edges will return the four lines which make up the edges of the rectangle.
 func (r Rect) Edges() [4]Line {
 	corners := r.Vertices()
@@ -588,6 +806,33 @@ func (r Rect) ResizedMin(size Vec) Rect {
 	}
 }
 
+// ResizedMax returns the Rect resized to the given size while keeping the position of the Rect's
+// Max.
+//
+// Sizes of zero area are safe here.
+func (r Rect) ResizedMax(size Vec) Rect {
+	return Rect{
+		Min: r.Max.Sub(size),
+		Max: r.Max,
+	}
+}
+
+// ResizedRelative returns the Rect resized to the given size while keeping the position of an
+// anchor given relative to the Rect, where V(0, 0) is the Min, V(1, 1) is the Max and V(0.5, 0.5)
+// is the center.
+//
+//   r.ResizedRelative(pixel.V(0.5, 0.5), size) // grows from the center
+//   r.ResizedRelative(pixel.V(0.5, 1), size)   // grows down from the middle of the top edge
+//
+// Unlike Resized, this is safe for Rects and sizes of zero area, and negative sizes too.
+func (r Rect) ResizedRelative(anchor, size Vec) Rect {
+	min := r.Min.Add(anchor.ScaledXY(r.Size())).Sub(anchor.ScaledXY(size))
+	return Rect{
+		Min: min,
+		Max: min.Add(size),
+	}
+}
+
 // Contains checks whether a vector u is contained within this Rect (including it's borders).
 func (r Rect) Contains(u Vec) bool {
 	return r.Min.X <= u.X && u.X <= r.Max.X && r.Min.Y <= u.Y && u.Y <= r.Max.Y
@@ -1002,6 +1247,403 @@ func (c Circle) IntersectionPoints(l Line) []Vec {
 	return []Vec{second, first}
 }
 
+// Polygon is a 2D polygon defined by the list of its vertices. The last vertex is implicitly
+// connected to the first one.
+//
+// Polygon is a plain slice, so a list of vectors can be used as a Polygon directly:
+//
+//   p := pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(5, 10)}
+//   p.IsConvex() // returns true
+type Polygon []Vec
+
+// ConvexHull returns the smallest convex Polygon containing all of the supplied points. The
+// vertices of the hull are in counter-clockwise order and points lying on it's edges are left out.
+//
+// If there are less than 3 points, they are returned unchanged.
+func ConvexHull(points []Vec) Polygon {
+	if len(points) < 3 {
+		return Polygon(points)
+	}
+
+	// monotone chain algorithm
+	sorted := append(vecsByXY(nil), points...)
+	sort.Sort(sorted)
+
+	unique := sorted[:1]
+	for _, u := range sorted[1:] {
+		if u != unique[len(unique)-1] {
+			unique = append(unique, u)
+		}
+	}
+	if len(unique) == 1 {
+		return Polygon{unique[0]}
+	}
+	sorted = unique
+
+	hull := make(Polygon, 0, 2*len(sorted))
+	for _, u := range sorted {
+		hull = appendHullPoint(hull, 0, u)
+	}
+	lower := len(hull)
+	for i := len(sorted) - 2; i >= 0; i-- {
+		hull = appendHullPoint(hull, lower-1, sorted[i])
+	}
+
+	// the last point is the same as the first one
+	return hull[:len(hull)-1]
+}
+
+// appendHullPoint appends u to the hull, removing points after index min, which would not make a
+// left turn with u.
+func appendHullPoint(hull Polygon, min int, u Vec) Polygon {
+	for len(hull) >= min+2 {
+		a, b := hull[len(hull)-2], hull[len(hull)-1]
+		if a.To(b).Cross(b.To(u)) > 0 {
+			break
+		}
+		hull = hull[:len(hull)-1]
+	}
+	return append(hull, u)
+}
+
+type vecsByXY []Vec
+
+func (v vecsByXY) Len() int      { return len(v) }
+func (v vecsByXY) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v vecsByXY) Less(i, j int) bool {
+	if v[i].X != v[j].X {
+		return v[i].X < v[j].X
+	}
+	return v[i].Y < v[j].Y
+}
+
+// IsConvex returns whether the Polygon is convex. The orientation of the vertices does not matter
+// and collinear vertices are allowed.
+//
+// Polygons with less than 3 vertices are considered convex.
+func (p Polygon) IsConvex() bool {
+	sign := 0.0
+	for i := range p {
+		a, b, c := p[i], p[(i+1)%len(p)], p[(i+2)%len(p)]
+		cross := a.To(b).Cross(b.To(c))
+		if cross == 0 {
+			continue
+		}
+		if sign == 0 {
+			sign = cross
+			continue
+		}
+		if (cross > 0) != (sign > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Area returns the area of the Polygon. The orientation of the vertices does not matter.
+func (p Polygon) Area() float64 {
+	area := 0.0
+	for i := range p {
+		area += p[i].Cross(p[(i+1)%len(p)])
+	}
+	return math.Abs(area) / 2
+}
+
+// Centroid returns the center of mass of the Polygon.
+//
+// If the Polygon has zero area, the average of its vertices is returned instead. The centroid of
+// an empty Polygon is the zero vector.
+func (p Polygon) Centroid() Vec {
+	area, centroid := 0.0, ZV
+	for i := range p {
+		a, b := p[i], p[(i+1)%len(p)]
+		cross := a.Cross(b)
+		area += cross
+		centroid = centroid.Add(a.Add(b).Scaled(cross))
+	}
+	if area != 0 {
+		return centroid.Scaled(1 / (3 * area))
+	}
+
+	centroid = ZV
+	for _, u := range p {
+		centroid = centroid.Add(u)
+	}
+	if len(p) > 0 {
+		centroid = centroid.Scaled(1 / float64(len(p)))
+	}
+	return centroid
+}
+
+// Transform projects all vertices of the Polygon by the given Matrix in place.
+func (p Polygon) Transform(m Matrix) {
+	for i := range p {
+		p[i] = m.Project(p[i])
+	}
+}
+
+// Scale scales the Polygon around it's Centroid by the given factor in place. The order of the
+// vertices is preserved.
+func (p Polygon) Scale(factor float64) {
+	p.Transform(IM.Scaled(p.Centroid(), factor))
+}
+
+// Rotate rotates the Polygon around it's Centroid by the given angle in radians in place. The order
+// of the vertices is preserved.
+func (p Polygon) Rotate(angle float64) {
+	p.Transform(IM.Rotated(p.Centroid(), angle))
+}
+
+// IntersectConvex returns the intersection of two convex Polygons, which is a convex Polygon too. The
+// orientation of the result is the orientation of a. If the Polygons don't overlap, or only touch,
+// an empty Polygon is returned.
+//
+// Both Polygons must be convex, otherwise the result is undefined. Note, that there is no union
+// counterpart, because the union of two convex Polygons generally isn't convex.
+func IntersectConvex(a, b Polygon) Polygon {
+	sign := 0.0
+	for i := range b {
+		sign += b[i].Cross(b[(i+1)%len(b)])
+	}
+	if len(a) < 3 || sign == 0 {
+		return Polygon{}
+	}
+
+	result := append(Polygon(nil), a...)
+	for i := range b {
+		ba, bb := b[i], b[(i+1)%len(b)]
+		dist := func(u Vec) float64 {
+			return ba.To(bb).Cross(ba.To(u)) * sign
+		}
+
+		// Sutherland-Hodgman clipping against the half-plane of one edge of b
+		clipped := make(Polygon, 0, len(result)+1)
+		for j := range result {
+			p, q := result[j], result[(j+1)%len(result)]
+			dp, dq := dist(p), dist(q)
+			if dp >= 0 {
+				clipped = append(clipped, p)
+			}
+			if (dp < 0 && dq > 0) || (dp > 0 && dq < 0) {
+				clipped = append(clipped, Lerp(p, q, dp/(dp-dq)))
+			}
+		}
+		result = clipped
+		if len(result) == 0 {
+			break
+		}
+	}
+
+	if result.Area() == 0 {
+		return Polygon{}
+	}
+	return result
+}
+
+// OffsetConvex returns a convex Polygon with each edge of p moved by the distance along it's normal,
+// outwards if the distance is positive and inwards if it's negative. This is useful for outlines and
+// collision margins. The orientation of the result is the orientation of p.
+//
+// Moving the edges outwards keeps the sharp corners of p, so the result has the same number of
+// vertices. Moving them inwards may make short edges disappear. If p collapses completely, an empty
+// Polygon is returned.
+//
+// The Polygon must be convex, otherwise the result is undefined.
+func OffsetConvex(p Polygon, distance float64) Polygon {
+	// repeated vertices make zero edges without a normal
+	poly := make(Polygon, 0, len(p))
+	for i, u := range p {
+		if u != p[(i+1)%len(p)] {
+			poly = append(poly, u)
+		}
+	}
+	sign := 0.0
+	for i := range poly {
+		sign += poly[i].Cross(poly[(i+1)%len(poly)])
+	}
+	if len(poly) < 3 || sign == 0 {
+		return Polygon{}
+	}
+	if sign > 0 {
+		sign = 1
+	} else {
+		sign = -1
+	}
+
+	// outward unit normal of the edge starting at the i-th vertex
+	normal := func(i int) Vec {
+		return poly[i].To(poly[(i+1)%len(poly)]).Normal().Unit().Scaled(-sign)
+	}
+
+	if distance >= 0 {
+		// each vertex lies on the intersection of the moved adjacent edges
+		result := make(Polygon, len(poly))
+		for i := range poly {
+			in, out := normal((i+len(poly)-1)%len(poly)), normal(i)
+			miter := in.Add(out).Unit()
+			result[i] = poly[i].Add(miter.Scaled(distance / miter.Dot(in)))
+		}
+		return result
+	}
+
+	// clip the Polygon by the half-plane of each moved edge
+	result := append(Polygon(nil), poly...)
+	for i := range poly {
+		a, n := poly[i], normal(i)
+		// how far inside of the moved edge a vector is
+		dist := func(u Vec) float64 {
+			return distance - a.To(u).Dot(n)
+		}
+
+		clipped := make(Polygon, 0, len(result)+1)
+		for j := range result {
+			u, v := result[j], result[(j+1)%len(result)]
+			du, dv := dist(u), dist(v)
+			if du >= 0 {
+				clipped = append(clipped, u)
+			}
+			if (du < 0 && dv > 0) || (du > 0 && dv < 0) {
+				clipped = append(clipped, Lerp(u, v, du/(du-dv)))
+			}
+		}
+		result = clipped
+		if len(result) == 0 {
+			break
+		}
+	}
+
+	if len(result) < 3 || result.Area() == 0 {
+		return Polygon{}
+	}
+	return result
+}
+
+// Contains checks whether a vector u is contained within the Polygon (including it's borders).
+//
+// Convex polygons are checked against the half-plane of each edge, other polygons are checked by
+// casting a ray and counting crossed edges.
+func (p Polygon) Contains(u Vec) bool {
+	for i := range p {
+		a, b := p[i], p[(i+1)%len(p)]
+		if a.To(b).Cross(a.To(u)) == 0 && R(a.X, a.Y, b.X, b.Y).Norm().Contains(u) {
+			return true
+		}
+	}
+	if len(p) < 3 {
+		return false
+	}
+
+	if p.IsConvex() {
+		sign := 0.0
+		for i := range p {
+			a, b := p[i], p[(i+1)%len(p)]
+			cross := a.To(b).Cross(a.To(u))
+			if cross == 0 {
+				continue
+			}
+			if sign == 0 {
+				sign = cross
+				continue
+			}
+			if (cross > 0) != (sign > 0) {
+				return false
+			}
+		}
+		return sign != 0
+	}
+
+	inside := false
+	for i := range p {
+		a, b := p[i], p[(i+1)%len(p)]
+		if (a.Y > u.Y) != (b.Y > u.Y) && u.X < a.X+(b.X-a.X)*(u.Y-a.Y)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// StarPolygon returns a regular star with the given number of points around the center. The
+// vertices alternate between the outer and the inner radius, starting with an outer vertex pointing
+// up, in counter-clockwise order.
+//
+// A 5 point star with the inner radius of about 0.38 times the outer one gives the classic shape.
+// If there are less than 2 points, an empty Polygon is returned.
+func StarPolygon(center Vec, outer, inner float64, points int) Polygon {
+	if points < 2 {
+		return Polygon{}
+	}
+	star := make(Polygon, 2*points)
+	for i := range star {
+		radius := outer
+		if i%2 == 1 {
+			radius = inner
+		}
+		angle := math.Pi/2 + float64(i)*math.Pi/float64(points)
+		star[i] = center.Add(Unit(angle).Scaled(radius))
+	}
+	return star
+}
+
+// MorphPolygons returns a Polygon with each vertex linearly interpolated between the corresponding
+// vertices of a and b by t. If t is 0, the result is a copy of a, if t is 1, it's a copy of b. This
+// is useful for shape tweens.
+//
+// If the Polygons have a different number of vertices, the one with fewer vertices gets new
+// vertices inserted along it's edges, spread by their length. The shape of that Polygon doesn't
+// change, so the result at 0 or 1 looks the same as the original, but has the additional
+// vertices. If either Polygon is empty, an empty Polygon is returned.
+//
+// The vertices are matched by their order, starting with the first vertex of each Polygon. For the
+// smoothest morphs, the Polygons should have the same orientation and similarly placed first
+// vertices.
+func MorphPolygons(a, b Polygon, t float64) Polygon {
+	if len(a) == 0 || len(b) == 0 {
+		return Polygon{}
+	}
+	if len(a) < len(b) {
+		a = subdividePolygon(a, len(b))
+	}
+	if len(b) < len(a) {
+		b = subdividePolygon(b, len(a))
+	}
+
+	morph := make(Polygon, len(a))
+	for i := range morph {
+		morph[i] = Lerp(a[i], b[i], t)
+	}
+	return morph
+}
+
+// subdividePolygon returns the Polygon with new vertices inserted along it's edges, so that it has
+// n vertices. Each new vertex goes to the edge, whose parts would be the longest.
+func subdividePolygon(p Polygon, n int) Polygon {
+	var (
+		lengths = make([]float64, len(p))
+		splits  = make([]int, len(p))
+	)
+	for i := range p {
+		lengths[i] = p[i].To(p[(i+1)%len(p)]).Len()
+	}
+	for added := len(p); added < n; added++ {
+		longest := 0
+		for i := range p {
+			if lengths[i]/float64(splits[i]+1) > lengths[longest]/float64(splits[longest]+1) {
+				longest = i
+			}
+		}
+		splits[longest]++
+	}
+
+	result := make(Polygon, 0, n)
+	for i := range p {
+		next := p[(i+1)%len(p)]
+		for j := 0; j <= splits[i]; j++ {
+			result = append(result, Lerp(p[i], next, float64(j)/float64(splits[i]+1)))
+		}
+	}
+	return result
+}
+
 // Matrix is a 2x3 affine matrix that can be used for all kinds of spatial transforms, such
 // as movement, scaling and rotations.
 //
@@ -1076,6 +1718,39 @@ func (m Matrix) Chained(next Matrix) Matrix {
 	}
 }
 
+// Compose chains all supplied matrices into one. The transformations of the first Matrix are
+// applied first and the transformations of the last Matrix are applied last, so for a child in a
+// scene graph:
+//
+//   world := pixel.Compose(local, parentWorld)
+//
+// Compose of no matrices is the identity Matrix.
+func Compose(ms ...Matrix) Matrix {
+	m := IM
+	for _, next := range ms {
+		m = m.Chained(next)
+	}
+	return m
+}
+
+// Inverse returns the Matrix which does the inverse transformations to this Matrix, so it's Project
+// does the same as Unproject of this Matrix. If the Matrix is not invertible, because it's
+// determinant is zero, the second return value is false.
+func (m Matrix) Inverse() (Matrix, bool) {
+	det := m[0]*m[3] - m[2]*m[1]
+	if det == 0 {
+		return Matrix{}, false
+	}
+	return Matrix{
+		m[3] / det,
+		-m[1] / det,
+		-m[2] / det,
+		m[0] / det,
+		(m[2]*m[5] - m[3]*m[4]) / det,
+		(m[1]*m[4] - m[0]*m[5]) / det,
+	}, true
+}
+
 // Project applies all transformations added to the Matrix to a vector u and returns the result.
 //
 // Time complexity is O(1).
@@ -1083,6 +1758,21 @@ func (m Matrix) Project(u Vec) Vec {
 	return Vec{m[0]*u.X + m[2]*u.Y + m[4], m[1]*u.X + m[3]*u.Y + m[5]}
 }
 
+// ProjectSlice projects all vectors in src by the Matrix and stores the results into dst. The
+// slices may be the same, which transforms the vectors in place:
+//
+//   m.ProjectSlice(points, points)
+//
+// If dst is shorter than src, this method panics.
+func (m Matrix) ProjectSlice(dst, src []Vec) {
+	if len(dst) < len(src) {
+		panic(fmt.Errorf("(%T).ProjectSlice: %d vectors do not fit into %d", m, len(src), len(dst)))
+	}
+	for i, u := range src {
+		dst[i] = Vec{m[0]*u.X + m[2]*u.Y + m[4], m[1]*u.X + m[3]*u.Y + m[5]}
+	}
+}
+
 // Unproject does the inverse operation to Project.
 //
 // Time complexity is O(1).
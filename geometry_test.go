@@ -23,6 +23,291 @@ func closeEnough(got, expected float64, decimalAccuracy int) bool {
 	return math.Trunc(gotShifted) == math.Trunc(expectedShifted)
 }
 
+func TestVec_Angle(t *testing.T) {
+	tests := []struct {
+		name string
+		u    pixel.Vec
+		want float64
+	}{
+		{name: "Positive x-axis", u: pixel.V(10, 0), want: 0},
+		{name: "Positive y-axis", u: pixel.V(0, 3), want: math.Pi / 2},
+		{name: "Negative x-axis", u: pixel.V(-1, 0), want: math.Pi},
+		{name: "Diagonal", u: pixel.V(-2, -2), want: -3 * math.Pi / 4},
+		{name: "Zero vector", u: pixel.ZV, want: 0},
+		{name: "Negative zero vector", u: pixel.ZV.Scaled(-1), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.u.Angle(), 1e-9)
+		})
+	}
+}
+
+func TestVec_Unit(t *testing.T) {
+	tests := []struct {
+		name  string
+		u     pixel.Vec
+		want  pixel.Vec
+		len   float64
+		sqLen float64
+	}{
+		{name: "Axis", u: pixel.V(0, -3), want: pixel.V(0, -1), len: 3, sqLen: 9},
+		{name: "Diagonal", u: pixel.V(3, 4), want: pixel.V(0.6, 0.8), len: 5, sqLen: 25},
+		{name: "Zero vector", u: pixel.ZV, want: pixel.V(1, 0), len: 0, sqLen: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.u.Unit()
+			assert.InDelta(t, tt.want.X, got.X, 1e-9)
+			assert.InDelta(t, tt.want.Y, got.Y, 1e-9)
+			assert.InDelta(t, tt.len, tt.u.Len(), 1e-9)
+			assert.InDelta(t, tt.sqLen, tt.u.SqLen(), 1e-9)
+		})
+	}
+}
+
+func TestPolar(t *testing.T) {
+	tests := []struct {
+		name          string
+		length, angle float64
+		want          pixel.Vec
+	}{
+		{name: "Zero angle", length: 2, angle: 0, want: pixel.V(2, 0)},
+		{name: "Right angle", length: 3, angle: math.Pi / 2, want: pixel.V(0, 3)},
+		{name: "Negative angle", length: 1, angle: -3 * math.Pi / 4, want: pixel.V(-math.Sqrt2/2, -math.Sqrt2/2)},
+		{name: "Zero length", length: 0, angle: 1, want: pixel.ZV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.Polar(tt.length, tt.angle)
+			assert.InDelta(t, tt.want.X, got.X, 1e-9)
+			assert.InDelta(t, tt.want.Y, got.Y, 1e-9)
+			if tt.length > 0 {
+				assert.InDelta(t, tt.length, got.Len(), 1e-9)
+				assert.InDelta(t, tt.angle, got.Angle(), 1e-9)
+			}
+			unit := pixel.Unit(tt.angle)
+			assert.InDelta(t, 1, unit.Len(), 1e-9)
+		})
+	}
+}
+
+func TestVec_Rotated(t *testing.T) {
+	tests := []struct {
+		name  string
+		u     pixel.Vec
+		angle float64
+		want  pixel.Vec
+	}{
+		{name: "Quarter turn", u: pixel.V(1, 0), angle: math.Pi / 2, want: pixel.V(0, 1)},
+		{name: "Half turn", u: pixel.V(3, 4), angle: math.Pi, want: pixel.V(-3, -4)},
+		{name: "Clockwise quarter turn", u: pixel.V(0, 2), angle: -math.Pi / 2, want: pixel.V(2, 0)},
+		{name: "Zero vector", u: pixel.ZV, angle: 1, want: pixel.ZV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.u.Rotated(tt.angle)
+			assert.InDelta(t, tt.want.X, got.X, 1e-9)
+			assert.InDelta(t, tt.want.Y, got.Y, 1e-9)
+		})
+	}
+}
+
+func TestVec_Project(t *testing.T) {
+	tests := []struct {
+		name string
+		u, v pixel.Vec
+		want pixel.Vec
+	}{
+		{name: "Onto x-axis", u: pixel.V(3, 4), v: pixel.V(2, 0), want: pixel.V(3, 0)},
+		{name: "Onto diagonal", u: pixel.V(2, 0), v: pixel.V(-1, -1), want: pixel.V(1, 1)},
+		{name: "Perpendicular", u: pixel.V(0, 5), v: pixel.V(3, 0), want: pixel.ZV},
+		{name: "Zero vector", u: pixel.ZV, v: pixel.V(3, 4), want: pixel.ZV},
+		{name: "Onto zero vector", u: pixel.V(3, 4), v: pixel.ZV, want: pixel.ZV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.u.Project(tt.v)
+			assert.InDelta(t, tt.want.X, got.X, 1e-9)
+			assert.InDelta(t, tt.want.Y, got.Y, 1e-9)
+		})
+	}
+}
+
+func TestVec_Components(t *testing.T) {
+	u, v := pixel.V(1.5, -2.5), pixel.V(-3, 4)
+	tests := []struct {
+		name string
+		got  pixel.Vec
+		want pixel.Vec
+	}{
+		{name: "Floor", got: u.Floor(), want: pixel.V(1, -3)},
+		{name: "Ceil", got: u.Ceil(), want: pixel.V(2, -2)},
+		{name: "Snapped", got: pixel.V(7, -7).Snapped(pixel.V(4, 4)), want: pixel.V(8, -8)},
+		{name: "Snapped halfway", got: u.Snapped(pixel.V(1, 1)), want: pixel.V(2, -2)},
+		{name: "Snapped zero grid", got: u.Snapped(pixel.V(0, 2)), want: pixel.V(1.5, -2)},
+		{name: "SnappedFloor", got: pixel.V(7, -7).SnappedFloor(pixel.V(4, 4)), want: pixel.V(4, -8)},
+		{name: "SnappedFloor zero grid", got: u.SnappedFloor(pixel.V(2, 0)), want: pixel.V(0, -2.5)},
+		{name: "Min", got: u.Min(v), want: pixel.V(-3, -2.5)},
+		{name: "Max", got: u.Max(v), want: pixel.V(1.5, 4)},
+		{name: "Map", got: u.Map(math.Abs), want: pixel.V(1.5, 2.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBezier(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []pixel.Vec
+		want []pixel.Vec
+	}{
+		{
+			name: "Quadratic",
+			got:  pixel.QuadraticBezier(pixel.V(0, 0), pixel.V(10, 20), pixel.V(20, 0), 2),
+			want: []pixel.Vec{pixel.V(0, 0), pixel.V(10, 10), pixel.V(20, 0)},
+		},
+		{
+			name: "Cubic",
+			got:  pixel.CubicBezier(pixel.V(0, 0), pixel.V(0, 8), pixel.V(8, 8), pixel.V(8, 0), 2),
+			want: []pixel.Vec{pixel.V(0, 0), pixel.V(4, 6), pixel.V(8, 0)},
+		},
+		{
+			name: "Less than one segment",
+			got:  pixel.CubicBezier(pixel.V(0, 0), pixel.V(0, 8), pixel.V(8, 8), pixel.V(8, 0), 0),
+			want: []pixel.Vec{pixel.V(0, 0), pixel.V(8, 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.got) != len(tt.want) {
+				t.Fatalf("got %d points, want %d", len(tt.got), len(tt.want))
+			}
+			for i := range tt.got {
+				assert.InDelta(t, tt.want[i].X, tt.got[i].X, 1e-9)
+				assert.InDelta(t, tt.want[i].Y, tt.got[i].Y, 1e-9)
+			}
+		})
+	}
+}
+
+func TestArcPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to float64
+		segments int
+		want     []pixel.Vec
+	}{
+		{
+			name: "Counterclockwise", from: 0, to: math.Pi, segments: 2,
+			want: []pixel.Vec{pixel.V(12, 10), pixel.V(10, 12), pixel.V(8, 10)},
+		},
+		{
+			name: "Clockwise", from: math.Pi / 2, to: -math.Pi / 2, segments: 2,
+			want: []pixel.Vec{pixel.V(10, 12), pixel.V(12, 10), pixel.V(10, 8)},
+		},
+		{
+			name: "Full circle", from: math.Pi, to: 3 * math.Pi, segments: 4,
+			want: []pixel.Vec{pixel.V(8, 10), pixel.V(10, 8), pixel.V(12, 10), pixel.V(10, 12), pixel.V(8, 10)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.ArcPoints(pixel.V(10, 10), 2, tt.from, tt.to, tt.segments)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d points, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				assert.InDelta(t, tt.want[i].X, got[i].X, 1e-9)
+				assert.InDelta(t, tt.want[i].Y, got[i].Y, 1e-9)
+			}
+		})
+	}
+
+	// a quarter of a circle approaches the area of a quarter of a disc
+	slice := pixel.PieSlice(pixel.V(10, 10), 2, 0, math.Pi/2, 1000)
+	assert.InDelta(t, math.Pi, slice.Area(), 1e-4)
+	if !slice.Contains(pixel.V(11, 11)) || slice.Contains(pixel.V(9, 11)) {
+		t.Errorf("PieSlice() covers the wrong quarter")
+	}
+}
+
+func TestCatmullRom(t *testing.T) {
+	tests := []struct {
+		name            string
+		points          []pixel.Vec
+		segmentsPerSpan int
+		want            []pixel.Vec
+	}{
+		{
+			name:            "Three points",
+			points:          []pixel.Vec{pixel.V(0, 0), pixel.V(1, 1), pixel.V(2, 0)},
+			segmentsPerSpan: 2,
+			want:            []pixel.Vec{pixel.V(0, 0), pixel.V(0.4375, 0.5625), pixel.V(1, 1), pixel.V(1.5625, 0.5625), pixel.V(2, 0)},
+		},
+		{
+			name:            "Less than one segment",
+			points:          []pixel.Vec{pixel.V(0, 0), pixel.V(1, 1), pixel.V(2, 0)},
+			segmentsPerSpan: 0,
+			want:            []pixel.Vec{pixel.V(0, 0), pixel.V(1, 1), pixel.V(2, 0)},
+		},
+		{
+			name:            "One point",
+			points:          []pixel.Vec{pixel.V(3, 4)},
+			segmentsPerSpan: 4,
+			want:            []pixel.Vec{pixel.V(3, 4)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.CatmullRom(tt.points, tt.segmentsPerSpan)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d points, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				assert.InDelta(t, tt.want[i].X, got[i].X, 1e-9)
+				assert.InDelta(t, tt.want[i].Y, got[i].Y, 1e-9)
+			}
+		})
+	}
+}
+
+func TestParseVec(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    pixel.Vec
+		wantErr bool
+	}{
+		{name: "String round trip", s: pixel.V(4.5, -1.3).String(), want: pixel.V(4.5, -1.3)},
+		{name: "Exponent", s: pixel.V(1e21, 0).String(), want: pixel.V(1e21, 0)},
+		{name: "Surrounding whitespace", s: "  Vec(1, 2)\n", want: pixel.V(1, 2)},
+		{name: "No space after comma", s: "Vec(1,2)", want: pixel.V(1, 2)},
+		{name: "Missing prefix", s: "(1, 2)", wantErr: true},
+		{name: "Missing parenthesis", s: "Vec(1, 2", wantErr: true},
+		{name: "One coordinate", s: "Vec(1)", wantErr: true},
+		{name: "Three coordinates", s: "Vec(1, 2, 3)", wantErr: true},
+		{name: "Not a number", s: "Vec(x, 2)", wantErr: true},
+		{name: "Empty", s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pixel.ParseVec(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseVec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRect_Edges(t *testing.T) {
 	type fields struct {
 		Min pixel.Vec
@@ -127,6 +412,56 @@ func TestRect_Resize(t *testing.T) {
 	}
 }
 
+func TestRect_ResizedRelative(t *testing.T) {
+	rect := pixel.R(0, 10, 40, 30)
+	tests := []struct {
+		name string
+		got  pixel.Rect
+		want pixel.Rect
+	}{
+		{name: "Center", got: rect.ResizedRelative(pixel.V(0.5, 0.5), pixel.V(20, 10)), want: pixel.R(10, 15, 30, 25)},
+		{name: "Min", got: rect.ResizedRelative(pixel.ZV, pixel.V(20, 10)), want: pixel.R(0, 10, 20, 20)},
+		{name: "Middle of top side", got: rect.ResizedRelative(pixel.V(0.5, 1), pixel.V(20, 10)), want: pixel.R(10, 20, 30, 30)},
+		{name: "Zero area source", got: pixel.R(5, 5, 5, 5).ResizedRelative(pixel.V(0.5, 0.5), pixel.V(4, 2)), want: pixel.R(3, 4, 7, 6)},
+		{name: "Zero size", got: rect.ResizedRelative(pixel.V(0.5, 0.5), pixel.ZV), want: pixel.R(20, 20, 20, 20)},
+		{name: "Negative size", got: rect.ResizedRelative(pixel.ZV, pixel.V(-10, 0)), want: pixel.R(0, 10, -10, 10)},
+		{name: "ResizedMax", got: rect.ResizedMax(pixel.V(20, 10)), want: pixel.R(20, 20, 40, 30)},
+		{name: "ResizedMax zero size", got: rect.ResizedMax(pixel.ZV), want: pixel.R(40, 30, 40, 30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRect_Empty(t *testing.T) {
+	tests := []struct {
+		name string
+		r    pixel.Rect
+		want bool
+	}{
+		{name: "Zero rect", r: pixel.Rect{}, want: true},
+		{name: "Zero width", r: pixel.R(5, 0, 5, 10), want: true},
+		{name: "Zero height", r: pixel.R(0, 5, 10, 5), want: true},
+		{name: "Non-empty", r: pixel.R(0, 0, 10, 10), want: false},
+		{name: "Inverted", r: pixel.R(10, 10, 0, 0), want: true},
+		{name: "Inverted width", r: pixel.R(10, 0, 0, 10), want: true},
+		{name: "Normalized inverted", r: pixel.R(10, 10, 0, 0).Norm(), want: false},
+		{name: "No overlap", r: pixel.R(0, 0, 10, 10).Intersect(pixel.R(20, 20, 30, 30)), want: true},
+		{name: "Overlap", r: pixel.R(0, 0, 10, 10).Intersect(pixel.R(5, 5, 30, 30)), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Empty(); got != tt.want {
+				t.Errorf("Rect.Empty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRect_Vertices(t *testing.T) {
 	type fields struct {
 		Min pixel.Vec
@@ -161,6 +496,403 @@ func TestRect_Vertices(t *testing.T) {
 	}
 }
 
+func TestConvexHull(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []pixel.Vec
+		want   pixel.Polygon
+	}{
+		{
+			name: "Square with inner points",
+			points: []pixel.Vec{
+				pixel.V(5, 5), pixel.V(10, 10), pixel.V(0, 0), pixel.V(2, 7),
+				pixel.V(0, 10), pixel.V(10, 0), pixel.V(3, 3),
+			},
+			want: pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)},
+		},
+		{
+			name: "Collinear points on edges",
+			points: []pixel.Vec{
+				pixel.V(0, 0), pixel.V(5, 0), pixel.V(10, 0), pixel.V(10, 5),
+				pixel.V(10, 10), pixel.V(0, 10), pixel.V(0, 5),
+			},
+			want: pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)},
+		},
+		{
+			name:   "Duplicate points",
+			points: []pixel.Vec{pixel.V(0, 0), pixel.V(4, 0), pixel.V(0, 0), pixel.V(0, 4), pixel.V(4, 0)},
+			want:   pixel.Polygon{pixel.V(0, 0), pixel.V(4, 0), pixel.V(0, 4)},
+		},
+		{
+			name:   "All collinear",
+			points: []pixel.Vec{pixel.V(2, 2), pixel.V(0, 0), pixel.V(1, 1)},
+			want:   pixel.Polygon{pixel.V(0, 0), pixel.V(2, 2)},
+		},
+		{
+			name:   "All the same",
+			points: []pixel.Vec{pixel.V(1, 1), pixel.V(1, 1), pixel.V(1, 1)},
+			want:   pixel.Polygon{pixel.V(1, 1)},
+		},
+		{
+			name:   "Less than 3 points",
+			points: []pixel.Vec{pixel.V(3, 0), pixel.V(0, 0)},
+			want:   pixel.Polygon{pixel.V(3, 0), pixel.V(0, 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.ConvexHull(tt.points)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ConvexHull() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("ConvexHull() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPolygon_IsConvex(t *testing.T) {
+	tests := []struct {
+		name string
+		p    pixel.Polygon
+		want bool
+	}{
+		{
+			name: "Triangle",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(5, 10)},
+			want: true,
+		},
+		{
+			name: "Clockwise square",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(0, 10), pixel.V(10, 10), pixel.V(10, 0)},
+			want: true,
+		},
+		{
+			name: "Square with collinear points",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(5, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)},
+			want: true,
+		},
+		{
+			name: "L shape",
+			p: pixel.Polygon{
+				pixel.V(0, 0), pixel.V(20, 0), pixel.V(20, 10),
+				pixel.V(10, 10), pixel.V(10, 20), pixel.V(0, 20),
+			},
+			want: false,
+		},
+		{
+			name: "Less than 3 points",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0)},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.IsConvex(); got != tt.want {
+				t.Errorf("Polygon.IsConvex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygon_Area(t *testing.T) {
+	tests := []struct {
+		name string
+		p    pixel.Polygon
+		want float64
+	}{
+		{
+			name: "Counter-clockwise square",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)},
+			want: 100,
+		},
+		{
+			name: "Clockwise square",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(0, 10), pixel.V(10, 10), pixel.V(10, 0)},
+			want: 100,
+		},
+		{
+			name: "L shape",
+			p: pixel.Polygon{
+				pixel.V(0, 0), pixel.V(20, 0), pixel.V(20, 10),
+				pixel.V(10, 10), pixel.V(10, 20), pixel.V(0, 20),
+			},
+			want: 300,
+		},
+		{
+			name: "Degenerate",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(20, 0)},
+			want: 0,
+		},
+		{
+			name: "Empty",
+			p:    nil,
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Area(); got != tt.want {
+				t.Errorf("Polygon.Area() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygon_Centroid(t *testing.T) {
+	tests := []struct {
+		name string
+		p    pixel.Polygon
+		want pixel.Vec
+	}{
+		{
+			name: "Clockwise square",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(0, 10), pixel.V(10, 10), pixel.V(10, 0)},
+			want: pixel.V(5, 5),
+		},
+		{
+			name: "L shape",
+			p: pixel.Polygon{
+				pixel.V(0, 0), pixel.V(20, 0), pixel.V(20, 10),
+				pixel.V(10, 10), pixel.V(10, 20), pixel.V(0, 20),
+			},
+			want: pixel.V(25.0/3, 25.0/3),
+		},
+		{
+			name: "Degenerate",
+			p:    pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(20, 0)},
+			want: pixel.V(10, 0),
+		},
+		{
+			name: "Empty",
+			p:    nil,
+			want: pixel.ZV,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.p.Centroid()
+			assert.InDelta(t, tt.want.X, got.X, 1e-9)
+			assert.InDelta(t, tt.want.Y, got.Y, 1e-9)
+		})
+	}
+}
+
+func TestPolygon_ScaleRotate(t *testing.T) {
+	square := func() pixel.Polygon {
+		return pixel.Polygon{pixel.V(2, 2), pixel.V(4, 2), pixel.V(4, 4), pixel.V(2, 4)}
+	}
+	tests := []struct {
+		name      string
+		transform func(pixel.Polygon)
+		want      pixel.Polygon
+	}{
+		{
+			name:      "Scale",
+			transform: func(p pixel.Polygon) { p.Scale(2) },
+			want:      pixel.Polygon{pixel.V(1, 1), pixel.V(5, 1), pixel.V(5, 5), pixel.V(1, 5)},
+		},
+		{
+			name:      "Rotate",
+			transform: func(p pixel.Polygon) { p.Rotate(math.Pi / 2) },
+			want:      pixel.Polygon{pixel.V(4, 2), pixel.V(4, 4), pixel.V(2, 4), pixel.V(2, 2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := square()
+			tt.transform(p)
+			for i := range p {
+				assert.InDelta(t, tt.want[i].X, p[i].X, 1e-9)
+				assert.InDelta(t, tt.want[i].Y, p[i].Y, 1e-9)
+			}
+		})
+	}
+}
+
+func TestIntersectConvex(t *testing.T) {
+	square := pixel.Polygon{pixel.V(0, 0), pixel.V(4, 0), pixel.V(4, 4), pixel.V(0, 4)}
+	tests := []struct {
+		name     string
+		a, b     pixel.Polygon
+		wantArea float64
+	}{
+		{
+			name:     "Overlapping squares",
+			a:        square,
+			b:        pixel.Polygon{pixel.V(2, 2), pixel.V(6, 2), pixel.V(6, 6), pixel.V(2, 6)},
+			wantArea: 4,
+		},
+		{
+			name:     "Clockwise clip",
+			a:        square,
+			b:        pixel.Polygon{pixel.V(2, -2), pixel.V(2, 6), pixel.V(6, 6), pixel.V(6, -2)},
+			wantArea: 8,
+		},
+		{
+			name:     "Contained triangle",
+			a:        square,
+			b:        pixel.Polygon{pixel.V(1, 1), pixel.V(3, 1), pixel.V(1, 3)},
+			wantArea: 2,
+		},
+		{
+			name:     "Touching",
+			a:        square,
+			b:        pixel.Polygon{pixel.V(4, 0), pixel.V(8, 0), pixel.V(8, 4), pixel.V(4, 4)},
+			wantArea: 0,
+		},
+		{
+			name:     "Disjoint",
+			a:        square,
+			b:        pixel.Polygon{pixel.V(10, 10), pixel.V(12, 10), pixel.V(11, 12)},
+			wantArea: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.IntersectConvex(tt.a, tt.b)
+			assert.InDelta(t, tt.wantArea, got.Area(), 1e-9)
+			if tt.wantArea == 0 && len(got) != 0 {
+				t.Errorf("IntersectConvex() = %v, want empty", got)
+			}
+			for _, u := range got {
+				if !tt.a.Contains(u) || !tt.b.Contains(u) {
+					t.Errorf("vertex %v is not in both polygons", u)
+				}
+			}
+		})
+	}
+}
+
+func TestOffsetConvex(t *testing.T) {
+	square := pixel.Polygon{pixel.V(0, 0), pixel.V(4, 0), pixel.V(4, 4), pixel.V(0, 4)}
+	clockwise := pixel.Polygon{pixel.V(0, 0), pixel.V(0, 4), pixel.V(4, 4), pixel.V(4, 0)}
+	tests := []struct {
+		name     string
+		p        pixel.Polygon
+		distance float64
+		wantArea float64
+	}{
+		{name: "Grow", p: square, distance: 1, wantArea: 36},
+		{name: "Grow clockwise", p: clockwise, distance: 1, wantArea: 36},
+		{name: "Zero", p: square, distance: 0, wantArea: 16},
+		{name: "Shrink", p: square, distance: -1, wantArea: 4},
+		{name: "Shrink clockwise", p: clockwise, distance: -1, wantArea: 4},
+		{name: "Collapse to a point", p: square, distance: -2, wantArea: 0},
+		{name: "Collapse", p: square, distance: -3, wantArea: 0},
+		{name: "Degenerate", p: pixel.Polygon{pixel.V(0, 0), pixel.V(1, 1), pixel.V(2, 2)}, distance: 1, wantArea: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.OffsetConvex(tt.p, tt.distance)
+			assert.InDelta(t, tt.wantArea, got.Area(), 1e-9)
+			if tt.wantArea == 0 && len(got) != 0 {
+				t.Errorf("OffsetConvex() = %v, want empty", got)
+			}
+			if !got.IsConvex() {
+				t.Errorf("OffsetConvex() = %v, want convex", got)
+			}
+		})
+	}
+
+	// growing keeps the sharp corners
+	got := pixel.OffsetConvex(square, 1)
+	if got[0] != pixel.V(-1, -1) || got[2] != pixel.V(5, 5) {
+		t.Errorf("OffsetConvex() = %v, want corners (-1, -1) and (5, 5)", got)
+	}
+}
+
+func TestPolygon_Contains(t *testing.T) {
+	square := pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)}
+	lShape := pixel.Polygon{
+		pixel.V(0, 0), pixel.V(20, 0), pixel.V(20, 10),
+		pixel.V(10, 10), pixel.V(10, 20), pixel.V(0, 20),
+	}
+	tests := []struct {
+		name string
+		p    pixel.Polygon
+		u    pixel.Vec
+		want bool
+	}{
+		{name: "Convex inside", p: square, u: pixel.V(5, 5), want: true},
+		{name: "Convex outside", p: square, u: pixel.V(15, 5), want: false},
+		{name: "Convex on edge", p: square, u: pixel.V(10, 5), want: true},
+		{name: "Convex on vertex", p: square, u: pixel.V(0, 0), want: true},
+		{name: "Convex on edge extension", p: square, u: pixel.V(20, 0), want: false},
+		{name: "Concave inside", p: lShape, u: pixel.V(5, 15), want: true},
+		{name: "Concave in the notch", p: lShape, u: pixel.V(15, 15), want: false},
+		{name: "Concave on inner edge", p: lShape, u: pixel.V(15, 10), want: true},
+		{name: "Concave on ray through vertex", p: lShape, u: pixel.V(5, 10), want: true},
+		{name: "Degenerate", p: pixel.Polygon{pixel.V(0, 0), pixel.V(10, 0)}, u: pixel.V(20, 0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Contains(tt.u); got != tt.want {
+				t.Errorf("Polygon.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStarPolygon(t *testing.T) {
+	star := pixel.StarPolygon(pixel.V(10, 10), 5, 2, 4)
+	want := pixel.Polygon{
+		pixel.V(10, 15), pixel.V(10-math.Sqrt2, 10+math.Sqrt2),
+		pixel.V(5, 10), pixel.V(10-math.Sqrt2, 10-math.Sqrt2),
+		pixel.V(10, 5), pixel.V(10+math.Sqrt2, 10-math.Sqrt2),
+		pixel.V(15, 10), pixel.V(10+math.Sqrt2, 10+math.Sqrt2),
+	}
+	if len(star) != len(want) {
+		t.Fatalf("StarPolygon() has %d vertices, want %d", len(star), len(want))
+	}
+	for i := range star {
+		assert.InDelta(t, want[i].X, star[i].X, 1e-9)
+		assert.InDelta(t, want[i].Y, star[i].Y, 1e-9)
+	}
+	if star.IsConvex() {
+		t.Errorf("StarPolygon() is convex")
+	}
+
+	if got := pixel.StarPolygon(pixel.ZV, 5, 2, 1); len(got) != 0 {
+		t.Errorf("StarPolygon() with 1 point = %v, want empty", got)
+	}
+}
+
+func TestMorphPolygons(t *testing.T) {
+	square := pixel.Polygon{pixel.V(0, 0), pixel.V(4, 0), pixel.V(4, 4), pixel.V(0, 4)}
+	triangle := pixel.Polygon{pixel.V(0, 0), pixel.V(8, 0), pixel.V(0, 4)}
+	tests := []struct {
+		name string
+		a, b pixel.Polygon
+		t    float64
+		want pixel.Polygon
+	}{
+		{name: "Start", a: square, b: triangle, t: 0, want: square},
+		{name: "Halfway", a: square, b: triangle, t: 0.5, want: pixel.Polygon{pixel.V(0, 0), pixel.V(6, 0), pixel.V(4, 3), pixel.V(0, 4)}},
+		{name: "End", a: square, b: triangle, t: 1, want: pixel.Polygon{pixel.V(0, 0), pixel.V(8, 0), pixel.V(4, 2), pixel.V(0, 4)}},
+		{name: "Fewer first", a: triangle, b: square, t: 0, want: pixel.Polygon{pixel.V(0, 0), pixel.V(8, 0), pixel.V(4, 2), pixel.V(0, 4)}},
+		{name: "Empty", a: square, b: pixel.Polygon{}, t: 0.5, want: pixel.Polygon{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.MorphPolygons(tt.a, tt.b, tt.t)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MorphPolygons() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// the inputs are not modified
+	got := pixel.MorphPolygons(square, square, 0)
+	got[0] = pixel.V(-1, -1)
+	if square[0] != pixel.ZV {
+		t.Errorf("MorphPolygons() result shares memory with the input")
+	}
+}
+
 func TestMatrix_Unproject(t *testing.T) {
 	const delta = 1e-15
 	t.Run("for rotated matrix", func(t *testing.T) {
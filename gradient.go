@@ -0,0 +1,97 @@
+package pixel
+
+import "image/color"
+
+// LinearGradient returns TrianglesData which fill the bounds with a linear gradient from one color
+// to another in the given direction. The from color is at the corner of the bounds furthest in the
+// opposite direction, the to color at the corner furthest in the direction.
+//
+// The direction doesn't need to be normalized. The zero direction is the same as V(1, 0).
+//
+// Draw the gradient using a Drawer:
+//
+//   d := pixel.Drawer{Triangles: pixel.LinearGradient(win.Bounds(), day, night, pixel.V(0, 1))}
+//   d.Draw(win)
+func LinearGradient(bounds Rect, from, to color.Color, direction Vec) *TrianglesData {
+	var (
+		fromRGBA = ToRGBA(from)
+		toRGBA   = ToRGBA(to)
+		dir      = direction.Unit()
+		corners  = bounds.Norm().Vertices()
+	)
+
+	min, max := corners[0].Dot(dir), corners[0].Dot(dir)
+	for _, u := range corners {
+		if d := u.Dot(dir); d < min {
+			min = d
+		} else if d > max {
+			max = d
+		}
+	}
+
+	colors := [4]RGBA{}
+	for i, u := range corners {
+		t := 0.0
+		if max > min {
+			t = (u.Dot(dir) - min) / (max - min)
+		}
+		colors[i] = LerpRGBA(fromRGBA, toRGBA, t)
+	}
+
+	td := MakeTrianglesData(6)
+	for i, k := range [...]int{0, 1, 2, 0, 2, 3} {
+		(*td)[i].Position = corners[k]
+		(*td)[i].Color = colors[k]
+	}
+	return td
+}
+
+// RadialGradient returns TrianglesData which fill the bounds with a radial gradient around the
+// center. The inner color is at the center, the outer color at the furthest corner of the bounds.
+//
+// The bounds are filled by a fan of triangles from the center, where each side of the bounds is
+// split into the given number of segments (at least 1). More segments make the gradient smoother.
+// The center is clamped to the bounds.
+func RadialGradient(bounds Rect, center Vec, inner, outer color.Color, segments int) *TrianglesData {
+	if segments < 1 {
+		segments = 1
+	}
+
+	var (
+		innerRGBA = ToRGBA(inner)
+		outerRGBA = ToRGBA(outer)
+		corners   = bounds.Norm().Vertices()
+	)
+
+	center = V(
+		Clamp(center.X, corners[0].X, corners[2].X),
+		Clamp(center.Y, corners[0].Y, corners[2].Y),
+	)
+	radius := 0.0
+	for _, u := range corners {
+		if d := center.To(u).Len(); d > radius {
+			radius = d
+		}
+	}
+	colorAt := func(u Vec) RGBA {
+		if radius == 0 {
+			return innerRGBA
+		}
+		return LerpRGBA(innerRGBA, outerRGBA, center.To(u).Len()/radius)
+	}
+
+	td := MakeTrianglesData(3 * 4 * segments)
+	j := 0
+	for k := range corners {
+		a, b := corners[k], corners[(k+1)%4]
+		for i := 0; i < segments; i++ {
+			p := Lerp(a, b, float64(i)/float64(segments))
+			q := Lerp(a, b, float64(i+1)/float64(segments))
+			(*td)[j+0].Position, (*td)[j+0].Color = center, innerRGBA
+			(*td)[j+1].Position, (*td)[j+1].Color = p, colorAt(p)
+			(*td)[j+2].Position, (*td)[j+2].Color = q, colorAt(q)
+			j += 3
+		}
+	}
+	return td
+}
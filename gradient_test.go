@@ -0,0 +1,57 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestLinearGradient(t *testing.T) {
+	black, white := pixel.RGB(0, 0, 0), pixel.RGB(1, 1, 1)
+	tests := []struct {
+		name      string
+		direction pixel.Vec
+		at        pixel.Vec
+		want      pixel.RGBA
+	}{
+		{name: "Right", direction: pixel.V(2, 0), at: pixel.V(0.5, 3.5), want: pixel.LerpRGBA(black, white, 0.125)},
+		{name: "Left", direction: pixel.V(-1, 0), at: pixel.V(0.5, 3.5), want: pixel.LerpRGBA(black, white, 0.875)},
+		{name: "Up", direction: pixel.V(0, 1), at: pixel.V(0.5, 3.5), want: pixel.LerpRGBA(black, white, 0.875)},
+		{name: "Diagonal", direction: pixel.V(1, 1), at: pixel.V(3.5, 0.5), want: pixel.LerpRGBA(black, white, 0.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := pixel.NewImageTarget(pixel.R(0, 0, 4, 4))
+			d := pixel.Drawer{Triangles: pixel.LinearGradient(it.Bounds(), black, white, tt.direction)}
+			d.Draw(it)
+			if got := it.Color(tt.at); !rgbaNear(got, tt.want) {
+				t.Errorf("Color(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRadialGradient(t *testing.T) {
+	black, white := pixel.RGB(0, 0, 0), pixel.RGB(1, 1, 1)
+	it := pixel.NewImageTarget(pixel.R(0, 0, 20, 20))
+	d := pixel.Drawer{Triangles: pixel.RadialGradient(it.Bounds(), pixel.V(10, 10), white, black, 16)}
+	d.Draw(it)
+
+	tests := []struct {
+		name string
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{name: "Center", at: pixel.V(10.5, 10.5), want: pixel.LerpRGBA(white, black, 0.05)},
+		{name: "Middle of the side", at: pixel.V(19.5, 10.5), want: pixel.LerpRGBA(white, black, 9.5/10/1.4142)},
+		{name: "Corner", at: pixel.V(0.5, 0.5), want: pixel.LerpRGBA(white, black, 0.95)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := it.Color(tt.at)
+			if d := got.Sub(tt.want); d.R < -0.02 || d.R > 0.02 || got.A != 1 {
+				t.Errorf("Color(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package pixel
+
+import "image/color"
+
+// Group is a Drawable consisting of other Drawables, which are all drawn transformed by the same
+// Matrix and multiplied by the same color mask. This is useful for drawing objects made of multiple
+// parts, which move together.
+//
+// Groups can be nested. The Matrix of an inner Group is applied first, then the Matrix of the
+// outer Group. The Matrix of the Target the Group is drawn onto is applied last. Color masks of
+// nested Groups multiply.
+//
+// The zero value of Group is an empty Group with the identity Matrix and no color mask, just like
+// the one created by NewGroup.
+//
+// Note, that Group caches data for each Target it's drawn onto, just like a Drawer does.
+type Group struct {
+	items   []Drawable
+	mat     Matrix
+	col     RGBA
+	targets map[Target]*groupTarget
+	inited  bool
+}
+
+var _ Drawable = (*Group)(nil)
+
+// NewGroup creates a new Group of the supplied Drawables with the identity Matrix and no color mask.
+func NewGroup(items ...Drawable) *Group {
+	g := &Group{items: items}
+	g.lazyInit()
+	return g
+}
+
+func (g *Group) lazyInit() {
+	if !g.inited {
+		g.mat = IM
+		g.col = Alpha(1)
+		g.targets = make(map[Target]*groupTarget)
+		g.inited = true
+	}
+}
+
+// Add adds Drawables to the Group. They are drawn in the order they were added.
+func (g *Group) Add(items ...Drawable) {
+	g.items = append(g.items, items...)
+}
+
+// SetMatrix sets a Matrix that all Drawables of the Group are transformed by.
+func (g *Group) SetMatrix(m Matrix) {
+	g.lazyInit()
+	g.mat = m
+}
+
+// Matrix returns the current Matrix of the Group.
+func (g *Group) Matrix() Matrix {
+	g.lazyInit()
+	return g.mat
+}
+
+// SetColorMask sets a color that all colors of the Drawables of the Group are multiplied by.
+//
+// If the mask is nil, a fully opaque white mask will be used, which causes no effect.
+func (g *Group) SetColorMask(c color.Color) {
+	g.lazyInit()
+	if c == nil {
+		g.col = Alpha(1)
+		return
+	}
+	g.col = ToRGBA(c)
+}
+
+// ColorMask returns the current color mask of the Group.
+func (g *Group) ColorMask() RGBA {
+	g.lazyInit()
+	return g.col
+}
+
+// Draw draws all Drawables of the Group onto the provided Target.
+func (g *Group) Draw(t Target) {
+	g.lazyInit()
+	gt := g.targets[t]
+	if gt == nil {
+		gt = &groupTarget{group: g, dst: t}
+		g.targets[t] = gt
+	}
+	for _, item := range g.items {
+		item.Draw(gt)
+	}
+}
+
+// groupTarget is a Target, which transforms all Triangles drawn onto it by the Matrix and the color
+// mask of the Group and draws them onto another Target.
+type groupTarget struct {
+	group *Group
+	dst   Target
+}
+
+func (gt *groupTarget) MakeTriangles(t Triangles) TargetTriangles {
+	return newWrappedTriangles(t, gt, gt.dst, gt.transform)
+}
+
+func (gt *groupTarget) MakePicture(p Picture) TargetPicture {
+	return &wrappedPicture{
+		pic:   gt.dst.MakePicture(p),
+		owner: gt,
+	}
+}
+
+// transform appends the vertices of src transformed by the current Matrix and color mask of the
+// Group to out.
+func (gt *groupTarget) transform(out, src *TrianglesData) {
+	out.Append(src)
+	out.Transform(gt.group.mat)
+	if col := gt.group.col; col != Alpha(1) {
+		out.TransformColors(col.Mul)
+	}
+}
@@ -0,0 +1,92 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestGroup_Draw(t *testing.T) {
+	// a unit square at the origin
+	square := func(c pixel.RGBA) pixel.Drawable {
+		tris := pixel.MakeTrianglesData(6)
+		for i, u := range []pixel.Vec{
+			pixel.V(0, 0), pixel.V(1, 0), pixel.V(1, 1),
+			pixel.V(0, 0), pixel.V(1, 1), pixel.V(0, 1),
+		} {
+			(*tris)[i].Position = u
+		}
+		tris.SetAllColors(c)
+		return &pixel.Drawer{Triangles: tris}
+	}
+	red, green := pixel.RGB(1, 0, 0), pixel.RGB(0, 1, 0)
+
+	inner := pixel.NewGroup(square(red))
+	inner.SetMatrix(pixel.IM.Moved(pixel.V(1, 0)))
+	outer := pixel.NewGroup(square(green), inner)
+	outer.SetMatrix(pixel.IM.Scaled(pixel.ZV, 2).Moved(pixel.V(0, 4)))
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+	outer.Draw(it)
+
+	tests := []struct {
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{at: pixel.V(1.5, 5.5), want: green},
+		{at: pixel.V(3.5, 5.5), want: red},
+		{at: pixel.V(5.5, 5.5), want: pixel.RGBA{}},
+		{at: pixel.V(1.5, 1.5), want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		if got := it.Color(tt.at); got != tt.want {
+			t.Errorf("Color(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	// changing the matrix moves the group in the next draw
+	outer.SetMatrix(pixel.IM)
+	it.Clear(pixel.RGBA{})
+	outer.Draw(it)
+	if got := it.Color(pixel.V(1.5, 0.5)); got != red {
+		t.Errorf("Color(%v) after SetMatrix = %v, want %v", pixel.V(1.5, 0.5), got, red)
+	}
+}
+
+func TestGroup_SetColorMask(t *testing.T) {
+	tris := &pixel.TrianglesData{
+		{Position: pixel.V(0, 0)}, {Position: pixel.V(2, 0)}, {Position: pixel.V(0, 2)},
+	}
+	tris.SetAllColors(pixel.RGB(1, 1, 0))
+
+	inner := pixel.NewGroup(&pixel.Drawer{Triangles: tris})
+	inner.SetColorMask(pixel.RGB(1, 0.5, 1))
+	outer := pixel.NewGroup(inner)
+	outer.SetColorMask(pixel.RGB(0.5, 1, 1))
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 1, 1))
+	outer.Draw(it)
+	if got, want := it.Color(pixel.V(0.5, 0.5)), pixel.RGB(0.5, 0.5, 0); !rgbaNear(got, want) {
+		t.Errorf("Color() = %v, want %v", got, want)
+	}
+}
+
+func TestGroup_ZeroValue(t *testing.T) {
+	tris := pixel.MakeTrianglesData(3)
+	for i, u := range []pixel.Vec{pixel.V(0, 0), pixel.V(2, 0), pixel.V(0, 2)} {
+		(*tris)[i].Position = u
+	}
+	tris.SetAllColors(pixel.RGB(0, 0, 1))
+
+	var g pixel.Group
+	if g.Matrix() != pixel.IM || g.ColorMask() != pixel.Alpha(1) {
+		t.Errorf("zero Group has Matrix %v and color mask %v, want %v and %v", g.Matrix(), g.ColorMask(), pixel.IM, pixel.Alpha(1))
+	}
+	g.Add(&pixel.Drawer{Triangles: tris})
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 2, 2))
+	g.Draw(it)
+	if got, want := it.Color(pixel.V(0.5, 0.5)), pixel.RGB(0, 0, 1); got != want {
+		t.Errorf("Color(%v) = %v, want %v", pixel.V(0.5, 0.5), got, want)
+	}
+}
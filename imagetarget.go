@@ -0,0 +1,328 @@
+package pixel
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+)
+
+// ImageTarget is an in-memory rectangular ComposeTarget and Picture at the same time, that you can
+// draw onto. All drawing is done purely in software, no GPU is needed. This is useful for taking
+// screenshots, generating images on a server or testing drawing code.
+//
+// It supports TrianglesPosition, TrianglesColor, TrianglesPicture and PictureColor. Pictures are
+// sampled by the nearest pixel.
+//
+// A pixel is drawn if its center lies inside a triangle. Vertex properties are interpolated over
+// the triangle. If the center lies exactly on an edge shared by two triangles, only one of them
+// draws the pixel, so there are no seams or overlaps between adjacent triangles.
+//
+// Triangles outside of the ImageTarget's Bounds and clipping rectangle are skipped. Triangles
+// reaching far outside are clipped before drawing, so even huge triangles are drawn precisely.
+type ImageTarget struct {
+	pd *PictureData
+
+	cmp  ComposeMethod
+	mat  Matrix
+	col  RGBA
+	clip Rect
+}
+
+var _ ComposeTarget = (*ImageTarget)(nil)
+
+// NewImageTarget creates a new empty, fully transparent ImageTarget with given bounds.
+func NewImageTarget(bounds Rect) *ImageTarget {
+	it := &ImageTarget{pd: MakePictureData(bounds)}
+	it.SetMatrix(IM)
+	it.SetColorMask(Alpha(1))
+	it.SetClip(it.Bounds())
+	return it
+}
+
+// MakeTriangles creates a specialized copy of the supplied Triangles that draws onto this
+// ImageTarget.
+func (it *ImageTarget) MakeTriangles(t Triangles) TargetTriangles {
+	td := MakeTrianglesData(t.Len())
+	td.Update(t)
+	return &imageTriangles{
+		TrianglesData: td,
+		dst:           it,
+	}
+}
+
+// MakePicture creates a specialized copy of the supplied Picture that draws onto this
+// ImageTarget.
+func (it *ImageTarget) MakePicture(p Picture) TargetPicture {
+	return &imagePicture{
+		pic: PictureDataFromPicture(p),
+		dst: it,
+	}
+}
+
+// SetMatrix sets a Matrix that every point will be projected by.
+func (it *ImageTarget) SetMatrix(m Matrix) {
+	it.mat = m
+}
+
+// SetColorMask sets a color that every color in triangles or a picture will be multiplied by.
+func (it *ImageTarget) SetColorMask(c color.Color) {
+	if c == nil {
+		it.col = Alpha(1)
+		return
+	}
+	it.col = ToRGBA(c)
+}
+
+// SetComposeMethod sets a Porter-Duff composition method to be used in the following draws onto
+// this ImageTarget.
+func (it *ImageTarget) SetComposeMethod(cmp ComposeMethod) {
+	it.cmp = cmp
+}
+
+// SetClip sets a rectangle that restricts all further drawing. Only pixels, whose centers lie inside
+// the rectangle, are drawn. The rectangle is in the coordinates of the ImageTarget, so it's not
+// affected by the Matrix. Clipping to an empty rectangle draws nothing.
+//
+// Clipping can be nested by intersecting the current rectangle and restoring it afterwards:
+//
+//   old := it.Clip()
+//   it.SetClip(old.Intersect(panel))
+//   // draw the content of the panel
+//   it.SetClip(old)
+//
+// The initial clipping rectangle is the Bounds of the ImageTarget. Clear is not affected by it.
+func (it *ImageTarget) SetClip(r Rect) {
+	it.clip = r.Norm()
+}
+
+// Clip returns the current clipping rectangle of the ImageTarget.
+func (it *ImageTarget) Clip() Rect {
+	return it.clip
+}
+
+// Bounds returns the rectangular bounds of the ImageTarget.
+func (it *ImageTarget) Bounds() Rect {
+	return it.pd.Bounds()
+}
+
+// Clear fills the whole ImageTarget with a single color.
+func (it *ImageTarget) Clear(c color.Color) {
+	rgba := toColorRGBA(ToRGBA(c).Mul(it.col))
+	for i := range it.pd.Pix {
+		it.pd.Pix[i] = rgba
+	}
+}
+
+// Color returns the color of the pixel over the given position inside the ImageTarget.
+func (it *ImageTarget) Color(at Vec) RGBA {
+	return it.pd.Color(at)
+}
+
+// Image returns the content of the ImageTarget as an image.RGBA.
+//
+// The resulting image.RGBA's Bounds will be equivalent of the ImageTarget's Bounds.
+func (it *ImageTarget) Image() *image.RGBA {
+	return it.pd.Image()
+}
+
+// imageGuardBand is how far outside of the drawn pixels, relative to their size, triangles may reach
+// before they're clipped. Triangles within the guard band are rasterized directly, larger ones are
+// clipped first, which keeps the edge functions precise for huge triangles.
+const imageGuardBand = 1.0
+
+func (it *ImageTarget) draw(td *TrianglesData, pic *PictureData) {
+	// range of pixels, whose centers lie inside both the bounds and the clipping rectangle
+	var (
+		minX = math.Max(math.Floor(it.pd.Rect.Min.X), math.Ceil(it.clip.Min.X-0.5))
+		minY = math.Max(math.Floor(it.pd.Rect.Min.Y), math.Ceil(it.clip.Min.Y-0.5))
+		maxX = math.Min(math.Ceil(it.pd.Rect.Max.X), math.Ceil(it.clip.Max.X-0.5)) - 1
+		maxY = math.Min(math.Ceil(it.pd.Rect.Max.Y), math.Ceil(it.clip.Max.Y-0.5)) - 1
+	)
+	if minX > maxX || minY > maxY {
+		return
+	}
+	pixels := R(minX, minY, maxX+1, maxY+1)
+	margin := imageGuardBand * math.Max(pixels.W(), pixels.H())
+	guard := R(pixels.Min.X-margin, pixels.Min.Y-margin, pixels.Max.X+margin, pixels.Max.Y+margin)
+
+	for i := 0; i+2 < td.Len(); i += 3 {
+		a, b, c := vertex((*td)[i]), vertex((*td)[i+1]), vertex((*td)[i+2])
+		a.Position = it.mat.Project(a.Position)
+		b.Position = it.mat.Project(b.Position)
+		c.Position = it.mat.Project(c.Position)
+
+		bounds := R(a.Position.X, a.Position.Y, a.Position.X, a.Position.Y).
+			Union(R(b.Position.X, b.Position.Y, b.Position.X, b.Position.Y)).
+			Union(R(c.Position.X, c.Position.Y, c.Position.X, c.Position.Y))
+
+		// triangles completely outside of the drawn pixels are skipped
+		if bounds.Max.X < pixels.Min.X || bounds.Min.X > pixels.Max.X ||
+			bounds.Max.Y < pixels.Min.Y || bounds.Min.Y > pixels.Max.Y {
+			continue
+		}
+
+		if guard.Contains(bounds.Min) && guard.Contains(bounds.Max) {
+			it.drawTriangle(a, b, c, pic, minX, minY, maxX, maxY)
+			continue
+		}
+
+		clipped := clipToRect([]vertex{a, b, c}, guard)
+		for j := 1; j+1 < len(clipped); j++ {
+			it.drawTriangle(clipped[0], clipped[j], clipped[j+1], pic, minX, minY, maxX, maxY)
+		}
+	}
+}
+
+// drawTriangle rasterizes a single triangle with projected positions into the given range of pixels.
+func (it *ImageTarget) drawTriangle(a, b, c vertex, pic *PictureData, minX, minY, maxX, maxY float64) {
+	area := a.Position.To(b.Position).Cross(a.Position.To(c.Position))
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		b, c = c, b
+		area = -area
+	}
+
+	// range of pixels, whose centers may lie inside the triangle
+	var (
+		x0 = math.Max(minX, math.Ceil(math.Min(a.Position.X, math.Min(b.Position.X, c.Position.X))-0.5))
+		y0 = math.Max(minY, math.Ceil(math.Min(a.Position.Y, math.Min(b.Position.Y, c.Position.Y))-0.5))
+		x1 = math.Min(maxX, math.Floor(math.Max(a.Position.X, math.Max(b.Position.X, c.Position.X))-0.5))
+		y1 = math.Min(maxY, math.Floor(math.Max(a.Position.Y, math.Max(b.Position.Y, c.Position.Y))-0.5))
+	)
+
+	for y := y0; y <= y1; y++ {
+		for x := x0; x <= x1; x++ {
+			center := V(x+0.5, y+0.5)
+			wa := b.Position.To(c.Position).Cross(b.Position.To(center))
+			wb := c.Position.To(a.Position).Cross(c.Position.To(center))
+			wc := a.Position.To(b.Position).Cross(a.Position.To(center))
+			if !ownsEdge(wa, b.Position, c.Position) ||
+				!ownsEdge(wb, c.Position, a.Position) ||
+				!ownsEdge(wc, a.Position, b.Position) {
+				continue
+			}
+			wa, wb, wc = wa/area, wb/area, wc/area
+
+			col := a.Color.Scaled(wa).Add(b.Color.Scaled(wb)).Add(c.Color.Scaled(wc))
+			if pic != nil {
+				intensity := a.Intensity*wa + b.Intensity*wb + c.Intensity*wc
+				if intensity != 0 {
+					at := a.Picture.Scaled(wa).Add(b.Picture.Scaled(wb)).Add(c.Picture.Scaled(wc))
+					col = col.Scaled(1 - intensity).Add(col.Mul(pic.Color(at)).Scaled(intensity))
+				}
+			}
+			col = col.Mul(it.col)
+
+			index := it.pd.Index(V(x, y))
+			it.pd.Pix[index] = toColorRGBA(it.cmp.Compose(col, fromColorRGBA(it.pd.Pix[index])))
+		}
+	}
+}
+
+// clipToRect clips a convex polygon of vertices to a rectangle, interpolating all vertex
+// properties along the clipped edges. The intersection of an edge is computed from it's endpoints
+// in a fixed order, so edges shared by two polygons are clipped at exactly the same vertex.
+func clipToRect(poly []vertex, r Rect) []vertex {
+	planes := [...]func(u Vec) float64{
+		func(u Vec) float64 { return u.X - r.Min.X },
+		func(u Vec) float64 { return r.Max.X - u.X },
+		func(u Vec) float64 { return u.Y - r.Min.Y },
+		func(u Vec) float64 { return r.Max.Y - u.Y },
+	}
+	for _, dist := range planes {
+		clipped := make([]vertex, 0, len(poly)+1)
+		for i := range poly {
+			p, q := poly[i], poly[(i+1)%len(poly)]
+			dp, dq := dist(p.Position), dist(q.Position)
+			if dp >= 0 {
+				clipped = append(clipped, p)
+			}
+			if (dp < 0 && dq > 0) || (dp > 0 && dq < 0) {
+				from, to, df, dt := p, q, dp, dq
+				if q.Position.X < p.Position.X || (q.Position.X == p.Position.X && q.Position.Y < p.Position.Y) {
+					from, to, df, dt = q, p, dq, dp
+				}
+				clipped = append(clipped, lerpVertex(from, to, df/(df-dt)))
+			}
+		}
+		poly = clipped
+		if len(poly) == 0 {
+			break
+		}
+	}
+	return poly
+}
+
+// ownsEdge reports whether a point with the edge function value w with respect to the edge from a
+// to b of a counter-clockwise triangle belongs to the triangle. Points exactly on an edge only
+// belong to the triangle if the edge is going down, or left when horizontal, which is false for
+// the other triangle sharing that edge.
+func ownsEdge(w float64, a, b Vec) bool {
+	if w != 0 {
+		return w > 0
+	}
+	d := a.To(b)
+	return d.Y < 0 || (d.Y == 0 && d.X < 0)
+}
+
+func fromColorRGBA(c color.RGBA) RGBA {
+	return RGBA{
+		float64(c.R) / 0xff,
+		float64(c.G) / 0xff,
+		float64(c.B) / 0xff,
+		float64(c.A) / 0xff,
+	}
+}
+
+func toColorRGBA(c RGBA) color.RGBA {
+	c = c.Clamped()
+	return color.RGBA{
+		R: uint8(c.R*0xff + 0.5),
+		G: uint8(c.G*0xff + 0.5),
+		B: uint8(c.B*0xff + 0.5),
+		A: uint8(c.A*0xff + 0.5),
+	}
+}
+
+type imageTriangles struct {
+	*TrianglesData
+	dst *ImageTarget
+}
+
+func (it *imageTriangles) Slice(i, j int) Triangles {
+	return &imageTriangles{
+		TrianglesData: it.TrianglesData.Slice(i, j).(*TrianglesData),
+		dst:           it.dst,
+	}
+}
+
+func (it *imageTriangles) Copy() Triangles {
+	return &imageTriangles{
+		TrianglesData: it.TrianglesData.Copy().(*TrianglesData),
+		dst:           it.dst,
+	}
+}
+
+func (it *imageTriangles) Draw() {
+	it.dst.draw(it.TrianglesData, nil)
+}
+
+type imagePicture struct {
+	pic *PictureData
+	dst *ImageTarget
+}
+
+func (ip *imagePicture) Bounds() Rect {
+	return ip.pic.Bounds()
+}
+
+func (ip *imagePicture) Draw(t TargetTriangles) {
+	it := t.(*imageTriangles)
+	if ip.dst != it.dst {
+		panic(fmt.Errorf("(%T).Draw: TargetTriangles generated by different ImageTarget", ip))
+	}
+	ip.dst.draw(it.TrianglesData, ip.pic)
+}
@@ -0,0 +1,225 @@
+package pixel_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestImageTarget_NoSeams(t *testing.T) {
+	it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+	it.SetComposeMethod(pixel.ComposePlus)
+
+	// two triangles sharing a diagonal, drawn with half alpha, so that any pixel drawn twice
+	// would end up more opaque than the others
+	half := pixel.Alpha(0.5)
+	tris := it.MakeTriangles(&pixel.TrianglesData{
+		{Position: pixel.V(0, 0), Color: half},
+		{Position: pixel.V(8, 0), Color: half},
+		{Position: pixel.V(8, 8), Color: half},
+		{Position: pixel.V(0, 0), Color: half},
+		{Position: pixel.V(8, 8), Color: half},
+		{Position: pixel.V(0, 8), Color: half},
+	})
+	tris.Draw()
+
+	img := it.Image()
+	want := color.RGBA{R: 128, G: 128, B: 128, A: 128}
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if got := img.RGBAAt(x, y); got != want {
+				t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestImageTarget_Colors(t *testing.T) {
+	it := pixel.NewImageTarget(pixel.R(0, 0, 4, 2))
+	it.SetMatrix(pixel.IM.Moved(pixel.V(1, 0)))
+	it.MakeTriangles(&pixel.TrianglesData{
+		{Position: pixel.V(-1, 0), Color: pixel.RGB(1, 0, 0)},
+		{Position: pixel.V(3, 0), Color: pixel.RGB(0, 0, 1)},
+		{Position: pixel.V(3, 1), Color: pixel.RGB(0, 0, 1)},
+		{Position: pixel.V(-1, 0), Color: pixel.RGB(1, 0, 0)},
+		{Position: pixel.V(3, 1), Color: pixel.RGB(0, 0, 1)},
+		{Position: pixel.V(-1, 1), Color: pixel.RGB(1, 0, 0)},
+	}).Draw()
+
+	tests := []struct {
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{at: pixel.V(0.5, 0.5), want: pixel.RGB(0.875, 0, 0.125)},
+		{at: pixel.V(3.5, 0.5), want: pixel.RGB(0.125, 0, 0.875)},
+		{at: pixel.V(1.5, 1.5), want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		got := it.Color(tt.at)
+		if !rgbaNear(got, tt.want) {
+			t.Errorf("ImageTarget.Color(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	// rows of image.RGBA go top to bottom
+	if got := it.Image().RGBAAt(0, 1); got.A != 0xff {
+		t.Errorf("Image().RGBAAt(0, 1) = %v, want opaque", got)
+	}
+	if got := it.Image().RGBAAt(0, 0); got.A != 0 {
+		t.Errorf("Image().RGBAAt(0, 0) = %v, want transparent", got)
+	}
+}
+
+func TestImageTarget_Sprite(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(0, 0, 2, 2))
+	pic.Pix[pic.Index(pixel.V(0, 0))] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(1, 0))] = color.RGBA{G: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(0, 1))] = color.RGBA{B: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(1, 1))] = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 4, 4))
+	it.Clear(pixel.RGB(0, 0, 0))
+	it.SetColorMask(pixel.Alpha(0.5))
+	pixel.NewSprite(pic, pic.Bounds()).Draw(it, pixel.IM.Scaled(pixel.ZV, 2).Moved(it.Bounds().Center()))
+
+	tests := []struct {
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{at: pixel.V(0.5, 0.5), want: pixel.RGB(0.5, 0, 0)},
+		{at: pixel.V(3.5, 1.5), want: pixel.RGB(0, 0.5, 0)},
+		{at: pixel.V(1.5, 3.5), want: pixel.RGB(0, 0, 0.5)},
+		{at: pixel.V(2.5, 2.5), want: pixel.RGB(0.5, 0.5, 0.5)},
+	}
+	for _, tt := range tests {
+		got := it.Color(tt.at)
+		if !rgbaNear(got, tt.want) {
+			t.Errorf("ImageTarget.Color(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
+
+func rgbaNear(a, b pixel.RGBA) bool {
+	d := a.Sub(b)
+	for _, x := range []float64{d.R, d.G, d.B, d.A} {
+		if x < -1.0/255 || x > 1.0/255 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestImageTarget_SetClip(t *testing.T) {
+	tests := []struct {
+		name  string
+		clip  pixel.Rect
+		drawn int
+	}{
+		{name: "Bounds", clip: pixel.R(0, 0, 8, 8), drawn: 64},
+		{name: "Inner", clip: pixel.R(2, 2, 6, 5), drawn: 12},
+		{name: "Fractional", clip: pixel.R(2.6, 2, 6.4, 5.4), drawn: 9},
+		{name: "Reversed", clip: pixel.R(6, 5, 2, 2), drawn: 12},
+		{name: "Outside", clip: pixel.R(-10, -10, 20, 20), drawn: 64},
+		{name: "Empty", clip: pixel.R(2, 2, 2, 6), drawn: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+			it.SetClip(tt.clip)
+			it.MakeTriangles(&pixel.TrianglesData{
+				{Position: pixel.V(-1, -1), Color: pixel.RGB(1, 1, 1)},
+				{Position: pixel.V(20, -1), Color: pixel.RGB(1, 1, 1)},
+				{Position: pixel.V(-1, 20), Color: pixel.RGB(1, 1, 1)},
+			}).Draw()
+
+			drawn := 0
+			img := it.Image()
+			for y := 0; y < 8; y++ {
+				for x := 0; x < 8; x++ {
+					if img.RGBAAt(x, y).A != 0 {
+						drawn++
+					}
+				}
+			}
+			if drawn != tt.drawn {
+				t.Errorf("drawn pixels = %d, want %d", drawn, tt.drawn)
+			}
+		})
+	}
+}
+
+func TestImageTarget_SetComposeMethod(t *testing.T) {
+	var (
+		gray = pixel.RGB(0.5, 0.5, 0.5)
+		fg   = pixel.RGBA{R: 0.5, G: 0, B: 0.25, A: 0.5}
+	)
+
+	tests := []struct {
+		name string
+		cmp  pixel.ComposeMethod
+		bg   pixel.RGBA
+		want pixel.RGBA
+	}{
+		{name: "Over", cmp: pixel.ComposeOver, bg: gray, want: pixel.RGB(0.75, 0.25, 0.5)},
+		{name: "Plus", cmp: pixel.ComposePlus, bg: gray, want: pixel.RGB(1, 0.5, 0.75)},
+		{name: "Multiply", cmp: pixel.ComposeMultiply, bg: gray, want: pixel.RGB(0.5, 0.25, 0.375)},
+		{name: "Multiply transparent", cmp: pixel.ComposeMultiply, bg: pixel.RGBA{}, want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := pixel.NewImageTarget(pixel.R(0, 0, 2, 2))
+			it.Clear(tt.bg)
+			it.SetComposeMethod(tt.cmp)
+			it.MakeTriangles(&pixel.TrianglesData{
+				{Position: pixel.V(0, 0), Color: fg},
+				{Position: pixel.V(2, 0), Color: fg},
+				{Position: pixel.V(2, 2), Color: fg},
+				{Position: pixel.V(0, 0), Color: fg},
+				{Position: pixel.V(2, 2), Color: fg},
+				{Position: pixel.V(0, 2), Color: fg},
+			}).Draw()
+
+			if got := it.Color(pixel.V(0.5, 0.5)); !rgbaNear(got, tt.want) {
+				t.Errorf("ImageTarget.Color() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageTarget_HugeTriangles(t *testing.T) {
+	// the red component grows linearly with x across the whole triangle, reaching far outside
+	it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+	it.MakeTriangles(&pixel.TrianglesData{
+		{Position: pixel.V(-100, 0), Color: pixel.RGBA{R: 0, A: 1}},
+		{Position: pixel.V(100, 0), Color: pixel.RGBA{R: 1, A: 1}},
+		{Position: pixel.V(0, 100), Color: pixel.RGBA{R: 0.5, A: 1}},
+	}).Draw()
+	for _, x := range []float64{0.5, 3.5, 7.5} {
+		want := pixel.RGBA{R: (x + 100) / 200, A: 1}
+		if got := it.Color(pixel.V(x, 4.5)); !rgbaNear(got, want) {
+			t.Errorf("ImageTarget.Color(%v) = %v, want %v", pixel.V(x, 4.5), got, want)
+		}
+	}
+
+	// two huge triangles sharing a diagonal through the ImageTarget, drawn with half alpha, so
+	// that any pixel drawn twice or missed would stand out
+	it = pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+	it.SetComposeMethod(pixel.ComposePlus)
+	half := pixel.Alpha(0.5)
+	it.MakeTriangles(&pixel.TrianglesData{
+		{Position: pixel.V(-1e6, -1e6+3), Color: half},
+		{Position: pixel.V(1e6, -1e6+3), Color: half},
+		{Position: pixel.V(1e6, 1e6+3), Color: half},
+		{Position: pixel.V(-1e6, -1e6+3), Color: half},
+		{Position: pixel.V(1e6, 1e6+3), Color: half},
+		{Position: pixel.V(-1e6, 1e6+3), Color: half},
+	}).Draw()
+	for y := 0.5; y < 8; y++ {
+		for x := 0.5; x < 8; x++ {
+			if got := it.Color(pixel.V(x, y)); !rgbaNear(got, half) {
+				t.Fatalf("ImageTarget.Color(%v) = %v, want %v", pixel.V(x, y), got, half)
+			}
+		}
+	}
+}
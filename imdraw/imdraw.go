@@ -33,7 +33,8 @@ import (
 //   - Color     - applies to all
 //   - Picture   - coordinates, only applies to filled polygons
 //   - Intensity - picture intensity, only applies to filled polygons
-//   - Precision - curve drawing precision, only applies to circles and ellipses
+//   - Precision - curve drawing precision, only applies to circles and ellipses (0 or less means
+//                 the precision is chosen automatically based on the radius)
 //   - EndShape  - shape of the end of a line, only applies to lines and outlines
 //
 // And here's the list of all shapes that can be drawn (all, except for line, can be filled or
@@ -51,16 +52,20 @@ type IMDraw struct {
 	Precision int
 	EndShape  EndShape
 
-	points []point
-	pool   [][]point
-	matrix pixel.Matrix
-	mask   pixel.RGBA
+	points    []point
+	pool      [][]point
+	matrix    pixel.Matrix
+	mask      pixel.RGBA
+	antialias bool
 
 	tri   *pixel.TrianglesData
 	batch *pixel.Batch
 }
 
-var _ pixel.BasicTarget = (*IMDraw)(nil)
+var (
+	_ pixel.BasicTarget = (*IMDraw)(nil)
+	_ pixel.Drawable    = (*IMDraw)(nil)
+)
 
 type point struct {
 	pos       pixel.Vec
@@ -108,7 +113,8 @@ func (imd *IMDraw) Clear() {
 
 // Reset restores all point properties to defaults and removes all Pushed points.
 //
-// This does not affect matrix and color mask set by SetMatrix and SetColorMask.
+// This does not affect matrix, color mask and antialiasing set by SetMatrix, SetColorMask and
+// SetAntialias.
 func (imd *IMDraw) Reset() {
 	imd.points = imd.points[:0]
 	imd.Color = pixel.Alpha(1)
@@ -160,6 +166,17 @@ func (imd *IMDraw) SetColorMask(color color.Color) {
 	imd.batch.SetColorMask(imd.mask)
 }
 
+// SetAntialias sets whether further filled polygons will have smooth edges. Smooth edges are made by
+// a thin fringe along the outside of the polygon, which fades out from the color of the polygon to
+// full transparency.
+//
+// The fringe is about one unit wide in the space the IMDraw's matrix projects into. This is only
+// approximate if the matrix scales unevenly, or if the IMDraw is drawn onto a Target that is
+// scaled further.
+func (imd *IMDraw) SetAntialias(antialias bool) {
+	imd.antialias = antialias
+}
+
 // MakeTriangles returns a specialized copy of the provided Triangles that draws onto this IMDraw.
 func (imd *IMDraw) MakeTriangles(t pixel.Triangles) pixel.TargetTriangles {
 	return imd.batch.MakeTriangles(t)
@@ -175,6 +192,16 @@ func (imd *IMDraw) Line(thickness float64) {
 	imd.polyline(thickness, false)
 }
 
+// DashedLine draws a dashed polyline of the specified thickness between the Pushed points. The
+// dashes are dash units long and separated by gaps gap units long, measured along the polyline. The
+// first dash starts at the first Pushed point and the pattern continues around the corners.
+//
+// Each dash is drawn like a Line, so the EndShape of the points applies to the ends of the dashes.
+// If either dash or gap is 0 or less, a solid line is drawn instead.
+func (imd *IMDraw) DashedLine(thickness, dash, gap float64) {
+	imd.dashedPolyline(thickness, dash, gap, false)
+}
+
 // Rectangle draws a rectangle between each two subsequent Pushed points. Drawing a rectangle
 // between two points means drawing a rectangle with sides parallel to the axes of the coordinate
 // system, where the two points specify it's two opposite corners.
@@ -189,21 +216,89 @@ func (imd *IMDraw) Rectangle(thickness float64) {
 	}
 }
 
-// Polygon draws a polygon from the Pushed points. If the thickness is 0, the convex polygon will be
-// filled. Otherwise, an outline of the specified thickness will be drawn. The outline does not have
-// to be convex.
+// RoundedRectangle draws a rectangle with rounded corners between each two subsequent Pushed points,
+// just like Rectangle. The corners are circle arcs of the specified radius, which is clamped to half
+// of the shorter side of the rectangle. With zero radius, it's the same as Rectangle.
+//
+// If the thickness is 0, rectangles will be filled, otherwise will be outlined with the given
+// thickness.
+func (imd *IMDraw) RoundedRectangle(radius, thickness float64) {
+	points := imd.getAndClearPoints()
+
+	if len(points) < 2 {
+		imd.restorePoints(points)
+		return
+	}
+
+	for i := 0; i+1 < len(points); i++ {
+		a, b := points[i], points[i+1]
+		mid := a
+		mid.col = a.col.Add(b.col).Mul(pixel.Alpha(0.5))
+		mid.in = (a.in + b.in) / 2
+		c, d := mid, mid
+		c.pos, c.pic = pixel.V(a.pos.X, b.pos.Y), pixel.V(a.pic.X, b.pic.Y)
+		d.pos, d.pic = pixel.V(b.pos.X, a.pos.Y), pixel.V(b.pic.X, a.pic.Y)
+
+		r := math.Min(math.Abs(radius), math.Min(math.Abs(b.pos.X-a.pos.X), math.Abs(b.pos.Y-a.pos.Y))/2)
+		if r == 0 {
+			// no arcs, so just a plain rectangle
+			imd.pushPt(a.pos, a)
+			imd.pushPt(b.pos, b)
+			imd.Rectangle(thickness)
+			continue
+		}
+
+		corners := [...]point{a, d, b, c}
+		for k, corner := range corners {
+			prev, next := corners[(k+3)%4], corners[(k+1)%4]
+			in := prev.pos.To(corner.pos).Unit()
+			out := corner.pos.To(next.pos).Unit()
+			center := corner.pos.Sub(in.Scaled(r)).Add(out.Scaled(r))
+
+			// quarter of a circle, from the end of the incoming side to the start of the outgoing one
+			num := math.Ceil(arcPrecision(corner.precision, pixel.V(r, r)) / 4)
+			for j := 0.0; j <= num; j++ {
+				angle := 0.0
+				if num > 0 {
+					angle = j / num * math.Pi / 2
+				}
+				sin, cos := math.Sincos(angle)
+				imd.pushPt(center.Add(out.Scaled(-r*cos)).Add(in.Scaled(r*sin)), corner)
+			}
+		}
+
+		if thickness == 0 {
+			imd.fillPolygon()
+		} else {
+			imd.polyline(thickness, true)
+		}
+	}
+
+	imd.restorePoints(points)
+}
+
+// Polygon draws a polygon from the Pushed points. If the thickness is 0, the polygon will be
+// filled. Otherwise, an outline of the specified thickness will be drawn.
 //
-// Note, that the filled polygon does not have to be strictly convex. The way it's drawn is that a
-// triangle is drawn between each two adjacent points and the first Pushed point. You can use this
-// property to draw certain kinds of concave polygons.
+// Neither the filled polygon nor the outline have to be convex. A convex polygon is filled by
+// drawing a triangle between each two adjacent points and the first Pushed point. A concave polygon
+// is triangulated by ear clipping, which is slower. Filling a self-intersecting polygon gives
+// undefined results.
 func (imd *IMDraw) Polygon(thickness float64) {
 	if thickness == 0 {
-		imd.fillPolygon()
+		imd.fillSimplePolygon()
 	} else {
 		imd.polyline(thickness, true)
 	}
 }
 
+// DashedPolygon draws a dashed outline of a polygon from the Pushed points. The dashes work the same
+// as in DashedLine, the pattern starts at the first Pushed point and continues over the closing
+// side. If either dash or gap is 0 or less, a solid outline is drawn instead.
+func (imd *IMDraw) DashedPolygon(thickness, dash, gap float64) {
+	imd.dashedPolyline(thickness, dash, gap, true)
+}
+
 // Circle draws a circle of the specified radius around each Pushed point. If the thickness is 0,
 // the circle will be filled, otherwise a circle outline of the specified thickness will be drawn.
 func (imd *IMDraw) Circle(radius, thickness float64) {
@@ -373,11 +468,67 @@ func (imd *IMDraw) fillPolygon() {
 	imd.restorePoints(points)
 }
 
+// fillSimplePolygon fills a polygon from the Pushed points, just like fillPolygon, except that the
+// polygon may be concave.
+func (imd *IMDraw) fillSimplePolygon() {
+	poly := make(pixel.Polygon, len(imd.points))
+	for i := range imd.points {
+		poly[i] = imd.points[i].pos
+	}
+
+	if imd.antialias && len(poly) >= 3 {
+		imd.fillPolygonFringe(poly)
+	}
+
+	if len(poly) < 3 || poly.IsConvex() {
+		imd.fillPolygon()
+		return
+	}
+
+	points := imd.getAndClearPoints()
+
+	indices := earClip(poly)
+
+	off := imd.tri.Len()
+	imd.tri.SetLen(imd.tri.Len() + len(indices))
+
+	for i, p := range indices {
+		tri := &(*imd.tri)[off+i]
+		tri.Position = points[p].pos
+		tri.Color = points[p].col
+		tri.Picture = points[p].pic
+		tri.Intensity = points[p].in
+	}
+
+	imd.applyMatrixAndMask(off)
+	imd.batch.Dirty()
+
+	imd.restorePoints(points)
+}
+
+// arcPrecision returns the number of segments of a full ellipse of the given radius. If the
+// precision is positive, it's returned unchanged. Otherwise, the number of segments is chosen so
+// that the ellipse deviates from the ideal shape by at most half a unit.
+func arcPrecision(precision int, radius pixel.Vec) float64 {
+	if precision > 0 {
+		return float64(precision)
+	}
+	r := math.Max(math.Abs(radius.X), math.Abs(radius.Y))
+	if r == 0 {
+		return 0
+	}
+	const tolerance = 0.5
+	if r <= tolerance {
+		return 8
+	}
+	return math.Max(8, math.Ceil(math.Pi/math.Acos(1-tolerance/r)))
+}
+
 func (imd *IMDraw) fillEllipseArc(radius pixel.Vec, low, high float64) {
 	points := imd.getAndClearPoints()
 
 	for _, pt := range points {
-		num := math.Ceil(math.Abs(high-low) / (2 * math.Pi) * float64(pt.precision))
+		num := math.Ceil(math.Abs(high-low) / (2 * math.Pi) * arcPrecision(pt.precision, radius))
 		delta := (high - low) / num
 
 		off := imd.tri.Len()
@@ -420,7 +571,7 @@ func (imd *IMDraw) outlineEllipseArc(radius pixel.Vec, low, high, thickness floa
 	points := imd.getAndClearPoints()
 
 	for _, pt := range points {
-		num := math.Ceil(math.Abs(high-low) / (2 * math.Pi) * float64(pt.precision))
+		num := math.Ceil(math.Abs(high-low) / (2 * math.Pi) * arcPrecision(pt.precision, radius))
 		delta := (high - low) / num
 
 		off := imd.tri.Len()
@@ -625,3 +776,218 @@ func (imd *IMDraw) polyline(thickness float64, closed bool) {
 
 	imd.restorePoints(points)
 }
+
+func (imd *IMDraw) dashedPolyline(thickness, dash, gap float64, closed bool) {
+	if dash <= 0 || gap <= 0 {
+		imd.polyline(thickness, closed)
+		return
+	}
+
+	points := imd.getAndClearPoints()
+	if closed && len(points) > 0 {
+		points = append(points, points[0])
+	}
+
+	// points of the current dash are Pushed and drawn as a polyline once the dash ends
+	push := func(pt point) {
+		if n := len(imd.points); n == 0 || imd.points[n-1].pos != pt.pos {
+			imd.pushPt(pt.pos, pt)
+		}
+	}
+	flush := func() {
+		if len(imd.points) >= 2 {
+			imd.polyline(thickness, false)
+		} else {
+			imd.points = imd.points[:0]
+		}
+	}
+
+	// left is the remaining length of the current dash or gap
+	on, left := true, dash
+	if len(points) > 0 {
+		push(points[0])
+	}
+	for i := 0; i+1 < len(points); i++ {
+		a, b := points[i], points[i+1]
+		length := a.pos.To(b.pos).Len()
+
+		pos := 0.0
+		for length-pos >= left {
+			pos += left
+			t := pos / length
+			pt := a
+			pt.pos = pixel.Lerp(a.pos, b.pos, t)
+			pt.col = pixel.LerpRGBA(a.col, b.col, t)
+			pt.pic = pixel.Lerp(a.pic, b.pic, t)
+			pt.in = a.in + (b.in-a.in)*t
+
+			if on {
+				push(pt)
+				flush()
+				left = gap
+			} else {
+				push(pt)
+				left = dash
+			}
+			on = !on
+		}
+		left -= length - pos
+
+		if on {
+			push(b)
+		}
+	}
+	if on {
+		flush()
+	}
+
+	imd.restorePoints(points)
+}
+
+// fillPolygonFringe draws a thin fringe along the outside of the polygon formed by the Pushed
+// points, fading from the color of the points to full transparency. The Pushed points are left in
+// place.
+func (imd *IMDraw) fillPolygonFringe(poly pixel.Polygon) {
+	det := imd.matrix[0]*imd.matrix[3] - imd.matrix[1]*imd.matrix[2]
+	if det == 0 {
+		return
+	}
+	width := 1 / math.Sqrt(math.Abs(det))
+
+	// the fringe goes to the right of the edges of a counter-clockwise polygon
+	area := 0.0
+	for i := range poly {
+		area += poly[i].Cross(poly[(i+1)%len(poly)])
+	}
+	outward := -width
+	if area < 0 {
+		outward = width
+	}
+
+	normal := func(i int) pixel.Vec {
+		return poly[i].To(poly[(i+1)%len(poly)]).Normal().Unit().Scaled(outward)
+	}
+	faded := func(p point, pos pixel.Vec) point {
+		p.pos = pos
+		p.col = pixel.Alpha(0)
+		return p
+	}
+
+	var fringe []point
+	for i := range poly {
+		if poly[i] == poly[(i+1)%len(poly)] {
+			continue
+		}
+		a, b := imd.points[i], imd.points[(i+1)%len(poly)]
+		n := normal(i)
+		fringe = append(fringe,
+			a, b, faded(b, b.pos.Add(n)),
+			a, faded(b, b.pos.Add(n)), faded(a, a.pos.Add(n)),
+		)
+
+		// fill the gap between the fringes of the two edges around a convex corner
+		j := (i + 1) % len(poly)
+		for poly[j] == poly[(j+1)%len(poly)] {
+			j = (j + 1) % len(poly)
+		}
+		m := normal(j)
+		if n.Cross(m)*outward < 0 {
+			fringe = append(fringe, b, faded(b, b.pos.Add(n)), faded(b, b.pos.Add(m)))
+		}
+	}
+
+	off := imd.tri.Len()
+	imd.tri.SetLen(imd.tri.Len() + len(fringe))
+
+	for i, p := range fringe {
+		tri := &(*imd.tri)[off+i]
+		tri.Position = p.pos
+		tri.Color = p.col
+		tri.Picture = p.pic
+		tri.Intensity = p.in
+	}
+
+	imd.applyMatrixAndMask(off)
+	imd.batch.Dirty()
+}
+
+// earClip triangulates a simple polygon and returns the indices of the vertices of the resulting
+// triangles, three per triangle.
+func earClip(poly pixel.Polygon) []int {
+	// work with counter-clockwise orientation, so that ears are the left turns
+	area := 0.0
+	for i := range poly {
+		area += poly[i].Cross(poly[(i+1)%len(poly)])
+	}
+
+	remaining := make([]int, len(poly))
+	for i := range remaining {
+		if area >= 0 {
+			remaining[i] = i
+		} else {
+			remaining[i] = len(poly) - 1 - i
+		}
+	}
+
+	indices := make([]int, 0, 3*(len(poly)-2))
+	for len(remaining) > 3 {
+		ear := -1
+		for i := range remaining {
+			if isEar(poly, remaining, i) {
+				ear = i
+				break
+			}
+		}
+		if ear < 0 {
+			// no ear found, get rid of a collinear vertex, which adds no area
+			for i := range remaining {
+				a := poly[remaining[(i+len(remaining)-1)%len(remaining)]]
+				b := poly[remaining[i]]
+				c := poly[remaining[(i+1)%len(remaining)]]
+				if a.To(b).Cross(b.To(c)) == 0 {
+					ear = i
+					break
+				}
+			}
+			if ear >= 0 {
+				remaining = append(remaining[:ear], remaining[ear+1:]...)
+				continue
+			}
+			// the polygon is not simple, clip anything to make progress
+			ear = 0
+		}
+
+		prev := remaining[(ear+len(remaining)-1)%len(remaining)]
+		next := remaining[(ear+1)%len(remaining)]
+		indices = append(indices, prev, remaining[ear], next)
+		remaining = append(remaining[:ear], remaining[ear+1:]...)
+	}
+	indices = append(indices, remaining...)
+
+	return indices
+}
+
+// isEar checks whether the i-th of the remaining vertices of a counter-clockwise polygon is an ear,
+// that is, it's a convex vertex and no other remaining vertex lies inside the triangle formed by it
+// and it's neighbours.
+func isEar(poly pixel.Polygon, remaining []int, i int) bool {
+	a := poly[remaining[(i+len(remaining)-1)%len(remaining)]]
+	b := poly[remaining[i]]
+	c := poly[remaining[(i+1)%len(remaining)]]
+
+	if a.To(b).Cross(b.To(c)) <= 0 {
+		return false
+	}
+
+	for _, j := range remaining {
+		p := poly[j]
+		if p == a || p == b || p == c {
+			continue
+		}
+		if a.To(b).Cross(a.To(p)) >= 0 && b.To(c).Cross(b.To(p)) >= 0 && c.To(a).Cross(c.To(p)) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
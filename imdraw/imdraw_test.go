@@ -2,6 +2,7 @@ package imdraw_test
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 
@@ -69,6 +70,160 @@ func BenchmarkPolygon(b *testing.B) {
 	}
 }
 
+func TestIMDraw_PolygonConcave(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []pixel.Vec
+		area   float64
+	}{
+		{
+			name:   "Convex square",
+			points: []pixel.Vec{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)},
+			area:   100,
+		},
+		{
+			name: "Concave L shape",
+			points: []pixel.Vec{
+				pixel.V(0, 0), pixel.V(20, 0), pixel.V(20, 10),
+				pixel.V(10, 10), pixel.V(10, 20), pixel.V(0, 20),
+			},
+			area: 300,
+		},
+		{
+			name: "Concave arrow clockwise",
+			points: []pixel.Vec{
+				pixel.V(0, 0), pixel.V(10, 20), pixel.V(20, 0), pixel.V(10, 5),
+			},
+			area: 150,
+		},
+		{
+			name: "Concave with collinear points",
+			points: []pixel.Vec{
+				pixel.V(0, 0), pixel.V(5, 0), pixel.V(10, 0), pixel.V(10, 10),
+				pixel.V(5, 5), pixel.V(0, 10),
+			},
+			area: 75,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imd := imdraw.New(nil)
+			imd.Push(tt.points...)
+			imd.Polygon(0)
+
+			tri := &pixel.TrianglesData{}
+			imd.Draw(pixel.NewBatch(tri, nil))
+
+			if tri.Len()%3 != 0 {
+				t.Fatalf("number of vertices %d is not a multiple of 3", tri.Len())
+			}
+			area := 0.0
+			for i := 0; i < tri.Len(); i += 3 {
+				a, b, c := tri.Position(i), tri.Position(i+1), tri.Position(i+2)
+				area += math.Abs(a.To(b).Cross(a.To(c))) / 2
+			}
+			if math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("triangulated area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestIMDraw_SetAntialias(t *testing.T) {
+	square := []pixel.Vec{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10), pixel.V(0, 10)}
+	tests := []struct {
+		name      string
+		antialias bool
+		matrix    pixel.Matrix
+		points    []pixel.Vec
+		len       int
+	}{
+		{name: "Disabled", antialias: false, matrix: pixel.IM, points: square, len: 6},
+		{name: "Enabled", antialias: true, matrix: pixel.IM, points: square, len: 6 + 4*6 + 4*3},
+		{name: "Enabled scaled", antialias: true, matrix: pixel.IM.Scaled(pixel.ZV, 4), points: square, len: 6 + 4*6 + 4*3},
+		{
+			name:      "Enabled clockwise concave",
+			antialias: true,
+			matrix:    pixel.IM,
+			points:    []pixel.Vec{pixel.V(0, 0), pixel.V(10, 20), pixel.V(20, 0), pixel.V(10, 5)},
+			len:       6 + 4*6 + 3*3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imd := imdraw.New(nil)
+			imd.SetMatrix(tt.matrix)
+			imd.SetAntialias(tt.antialias)
+			imd.Push(tt.points...)
+			imd.Polygon(0)
+
+			tri := &pixel.TrianglesData{}
+			imd.Draw(pixel.NewBatch(tri, nil))
+
+			if tri.Len() != tt.len {
+				t.Fatalf("got %d vertices, want %d", tri.Len(), tt.len)
+			}
+
+			poly := make(pixel.Polygon, len(tt.points))
+			for i := range tt.points {
+				poly[i] = tt.matrix.Project(tt.points[i])
+			}
+			for i := 0; i < tri.Len(); i++ {
+				pos, col := tri.Position(i), tri.Color(i)
+				if poly.Contains(pos) {
+					continue
+				}
+				if col != pixel.Alpha(0) {
+					t.Errorf("vertex %d outside of the polygon has color %v", i, col)
+				}
+				near := false
+				for j := range poly {
+					edge := pixel.L(poly[j], poly[(j+1)%len(poly)])
+					if edge.Closest(pos).To(pos).Len() <= 1+1e-9 {
+						near = true
+					}
+				}
+				if !near {
+					t.Errorf("vertex %d at %v is further than 1 unit from the polygon", i, pos)
+				}
+			}
+		})
+	}
+}
+
+func TestIMDraw_CircleAutoPrecision(t *testing.T) {
+	tests := []struct {
+		name   string
+		radius float64
+		empty  bool
+	}{
+		{name: "Zero radius", radius: 0, empty: true},
+		{name: "Tiny radius", radius: 0.1},
+		{name: "Large radius", radius: 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imd := imdraw.New(nil)
+			imd.Precision = 0
+			imd.Push(pixel.ZV)
+			imd.Circle(tt.radius, 0)
+
+			tri := &pixel.TrianglesData{}
+			imd.Draw(pixel.NewBatch(tri, nil))
+
+			if (tri.Len() == 0) != tt.empty {
+				t.Fatalf("got %d vertices, want empty: %v", tri.Len(), tt.empty)
+			}
+			for i := 0; i < tri.Len(); i++ {
+				pos := tri.Position(i)
+				if math.IsNaN(pos.X) || math.IsNaN(pos.Y) || pos.Len() > tt.radius+1e-9 {
+					t.Fatalf("vertex %d has invalid position %v", i, pos)
+				}
+			}
+		})
+	}
+}
+
 func BenchmarkEllipseFill(b *testing.B) {
 	lists := pointLists(1, 10, 100, 1000)
 	for _, pts := range lists {
@@ -94,3 +249,117 @@ func BenchmarkEllipseOutline(b *testing.B) {
 		})
 	}
 }
+
+func TestIMDraw_RoundedRectangle(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   pixel.Vec
+		radius float64
+		area   float64
+	}{
+		{name: "Zero radius", a: pixel.V(0, 0), b: pixel.V(20, 10), radius: 0, area: 200},
+		{name: "Rounded", a: pixel.V(0, 0), b: pixel.V(20, 10), radius: 2, area: 200 - (4-math.Pi)*4},
+		{name: "Reversed corners", a: pixel.V(20, 10), b: pixel.V(0, 0), radius: 2, area: 200 - (4-math.Pi)*4},
+		{name: "Clamped radius", a: pixel.V(0, 0), b: pixel.V(10, 10), radius: 100, area: math.Pi * 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imd := imdraw.New(nil)
+			imd.Precision = 4000
+			imd.Push(tt.a, tt.b)
+			imd.RoundedRectangle(tt.radius, 0)
+
+			tri := &pixel.TrianglesData{}
+			imd.Draw(pixel.NewBatch(tri, nil))
+
+			bounds := pixel.R(tt.a.X, tt.a.Y, tt.b.X, tt.b.Y).Norm()
+			area := 0.0
+			for i := 0; i < tri.Len(); i += 3 {
+				a, b, c := tri.Position(i), tri.Position(i+1), tri.Position(i+2)
+				area += math.Abs(a.To(b).Cross(a.To(c))) / 2
+				for _, u := range []pixel.Vec{a, b, c} {
+					if u.X < bounds.Min.X-1e-9 || u.X > bounds.Max.X+1e-9 || u.Y < bounds.Min.Y-1e-9 || u.Y > bounds.Max.Y+1e-9 {
+						t.Fatalf("vertex %v is outside of %v", u, bounds)
+					}
+				}
+			}
+			if math.Abs(area-tt.area) > 1e-3 {
+				t.Errorf("filled area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestIMDraw_RoundedRectangleZeroRadius(t *testing.T) {
+	for _, thickness := range []float64{0, 1} {
+		rounded, plain := &pixel.TrianglesData{}, &pixel.TrianglesData{}
+
+		imd := imdraw.New(nil)
+		imd.Push(pixel.V(0, 0), pixel.V(20, 10))
+		imd.RoundedRectangle(0, thickness)
+		imd.Draw(pixel.NewBatch(rounded, nil))
+
+		imd = imdraw.New(nil)
+		imd.Push(pixel.V(0, 0), pixel.V(20, 10))
+		imd.Rectangle(thickness)
+		imd.Draw(pixel.NewBatch(plain, nil))
+
+		if !rounded.Equal(plain, 1e-9) {
+			t.Errorf("RoundedRectangle(0, %v) has %d vertices, want the same %d as Rectangle(%v)", thickness, rounded.Len(), plain.Len(), thickness)
+		}
+	}
+}
+
+func TestIMDraw_DashedLine(t *testing.T) {
+	imd := imdraw.New(nil)
+	imd.Push(pixel.V(0, 0), pixel.V(10, 0))
+	imd.DashedLine(2, 2, 1)
+
+	tri := &pixel.TrianglesData{}
+	imd.Draw(pixel.NewBatch(tri, nil))
+
+	// dashes from 0 to 2, 3 to 5, 6 to 8 and 9 to 10
+	area := 0.0
+	for i := 0; i < tri.Len(); i += 3 {
+		a, b, c := tri.Position(i), tri.Position(i+1), tri.Position(i+2)
+		area += math.Abs(a.To(b).Cross(a.To(c))) / 2
+		for _, u := range []pixel.Vec{a, b, c} {
+			for _, gap := range [][2]float64{{2, 3}, {5, 6}, {8, 9}} {
+				if u.X > gap[0]+1e-9 && u.X < gap[1]-1e-9 {
+					t.Fatalf("vertex %v is inside of a gap", u)
+				}
+			}
+		}
+	}
+	if math.Abs(area-14) > 1e-9 {
+		t.Errorf("dashed area = %v, want %v", area, 14)
+	}
+}
+
+func TestIMDraw_DashedPolygon(t *testing.T) {
+	square := []pixel.Vec{pixel.V(0, 0), pixel.V(4, 0), pixel.V(4, 4), pixel.V(0, 4)}
+
+	// zero gap degenerates to a solid outline
+	solid, dashed := imdraw.New(nil), imdraw.New(nil)
+	solid.Push(square...)
+	solid.Polygon(1)
+	dashed.Push(square...)
+	dashed.DashedPolygon(1, 3, 0)
+
+	want, got := &pixel.TrianglesData{}, &pixel.TrianglesData{}
+	solid.Draw(pixel.NewBatch(want, nil))
+	dashed.Draw(pixel.NewBatch(got, nil))
+	if !got.Equal(want, 0) {
+		t.Errorf("DashedPolygon() with zero gap differs from Polygon()")
+	}
+
+	// the closing side is dashed too, a dash of length 3 ends right before each corner
+	dashed = imdraw.New(nil)
+	dashed.Push(square...)
+	dashed.DashedPolygon(1, 3, 1)
+	got = &pixel.TrianglesData{}
+	dashed.Draw(pixel.NewBatch(got, nil))
+	if got.Len() != 4*6 {
+		t.Errorf("DashedPolygon() drew %d vertices, want %d", got.Len(), 4*6)
+	}
+}
@@ -131,3 +131,33 @@ type PictureColor interface {
 	Picture
 	Color(at Vec) RGBA
 }
+
+// Drawable is anything that can be drawn onto a Target. Drawer, Batch, Group, NinePatch, Shadow,
+// ParticleSystem and IMDraw are all Drawables, so they can be stored together, for example in a list
+// of layers:
+//
+//   layers := []pixel.Drawable{background, batch, imd}
+//   for _, l := range layers {
+//   	l.Draw(win)
+//   }
+//
+// Sprite and Text take a Matrix in their Draw methods, so they're not Drawables on their own. Use
+// DrawableFunc to bind them to a Matrix.
+type Drawable interface {
+	Draw(Target)
+}
+
+// DrawableFunc is an adapter to allow the use of ordinary functions as Drawables.
+//
+// This way, objects which take additional arguments in their Draw method, such as a Sprite, can
+// be added to a Group:
+//
+//   group.Add(pixel.DrawableFunc(func(t pixel.Target) {
+//   	sprite.Draw(t, pixel.IM.Moved(offset))
+//   }))
+type DrawableFunc func(Target)
+
+// Draw calls f(t).
+func (f DrawableFunc) Draw(t Target) {
+	f(t)
+}
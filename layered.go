@@ -0,0 +1,51 @@
+package pixel
+
+import "sort"
+
+// Layered collects Drawables with z values and draws them sorted by their z values, from the lowest
+// to the highest. This way, objects can be submitted in any order and still be drawn correctly on
+// top of each other:
+//
+//   var layers pixel.Layered
+//   layers.Add(player, 1)
+//   layers.Add(background, 0)
+//   layers.Add(hud, 2)
+//   layers.Flush(win) // draws background, player, hud
+//
+// Drawables with equal z values are drawn in the order they were added. The zero value of Layered
+// is ready to use.
+type Layered struct {
+	items layeredItems
+}
+
+// Add adds a Drawable to be drawn at the given z value by the next Flush.
+func (l *Layered) Add(d Drawable, z float64) {
+	l.items = append(l.items, layeredItem{d: d, z: z})
+}
+
+// Len returns the number of Drawables waiting for the next Flush.
+func (l *Layered) Len() int {
+	return len(l.items)
+}
+
+// Flush draws all added Drawables onto the provided Target sorted by their z values and removes
+// them from the Layered.
+func (l *Layered) Flush(t Target) {
+	sort.Stable(l.items)
+	for i := range l.items {
+		l.items[i].d.Draw(t)
+		l.items[i].d = nil // don't keep the Drawable alive
+	}
+	l.items = l.items[:0]
+}
+
+type layeredItem struct {
+	d Drawable
+	z float64
+}
+
+type layeredItems []layeredItem
+
+func (li layeredItems) Len() int           { return len(li) }
+func (li layeredItems) Swap(i, j int)      { li[i], li[j] = li[j], li[i] }
+func (li layeredItems) Less(i, j int) bool { return li[i].z < li[j].z }
@@ -0,0 +1,40 @@
+package pixel_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestLayered(t *testing.T) {
+	var (
+		layers pixel.Layered
+		order  []string
+	)
+	add := func(name string, z float64) {
+		layers.Add(pixel.DrawableFunc(func(pixel.Target) {
+			order = append(order, name)
+		}), z)
+	}
+
+	add("player", 1)
+	add("background", -1)
+	add("enemy", 1)
+	add("hud", 10)
+	add("shadow", 0.5)
+	if layers.Len() != 5 {
+		t.Fatalf("Layered.Len() = %d, want 5", layers.Len())
+	}
+
+	layers.Flush(nil)
+	if want := []string{"background", "shadow", "player", "enemy", "hud"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Layered.Flush() order = %v, want %v", order, want)
+	}
+
+	order = nil
+	layers.Flush(nil)
+	if layers.Len() != 0 || len(order) != 0 {
+		t.Errorf("Layered.Flush() did not empty the Layered")
+	}
+}
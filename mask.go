@@ -0,0 +1,159 @@
+package pixel
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// MaskTarget is a Target which wraps another Target and restricts all drawing onto it to the area
+// of a convex Polygon mask. All triangles drawn onto it are clipped against the mask and only the
+// parts covered by the mask are drawn onto the wrapped Target. Vertex properties, such as colors
+// and picture coordinates, are interpolated along the clipped edges, so the visible parts look
+// exactly the same as when drawn without the mask.
+//
+// This is useful for reveal effects, windows and similar:
+//
+//   mt := pixel.NewMaskTarget(win, pixel.Polygon{pixel.V(0, 0), pixel.V(100, 0), pixel.V(50, 80)})
+//   sprite.Draw(mt, pixel.IM.Moved(pos))
+//
+// The mask is in the coordinates of the drawn triangles, so it's affected by the Matrix of the
+// wrapped Target. Triangles, which don't overlap the mask, draw nothing.
+//
+// The clipped triangles are generated again in each Draw, so drawing with it is slower than usual.
+type MaskTarget struct {
+	dst  Target
+	mask Polygon
+	sign float64
+}
+
+var _ BasicTarget = (*MaskTarget)(nil)
+
+// NewMaskTarget creates a MaskTarget which draws onto the supplied Target restricted to the area of
+// the supplied mask.
+//
+// The mask must be convex, otherwise this function panics. The orientation of it's vertices does
+// not matter. A mask with zero area covers nothing.
+func NewMaskTarget(t Target, mask Polygon) *MaskTarget {
+	mt := &MaskTarget{dst: t}
+	mt.SetMask(mask)
+	return mt
+}
+
+// SetMask changes the mask of the MaskTarget. The change affects all following draws, including
+// draws of the TargetTriangles created before.
+//
+// The mask must be convex, otherwise this method panics.
+func (mt *MaskTarget) SetMask(mask Polygon) {
+	if !mask.IsConvex() {
+		panic(fmt.Errorf("(%T).SetMask: mask is not convex", mt))
+	}
+	mt.mask = mask
+	mt.sign = 0
+	for i := range mask {
+		mt.sign += mask[i].Cross(mask[(i+1)%len(mask)])
+	}
+}
+
+// Mask returns the current mask of the MaskTarget.
+func (mt *MaskTarget) Mask() Polygon {
+	return mt.mask
+}
+
+// MakeTriangles creates a specialized copy of the supplied Triangles that draws onto the wrapped
+// Target clipped by the mask.
+func (mt *MaskTarget) MakeTriangles(t Triangles) TargetTriangles {
+	return newWrappedTriangles(t, mt, mt.dst, mt.clipAll)
+}
+
+// MakePicture creates a specialized copy of the supplied Picture that draws onto the wrapped Target
+// clipped by the mask.
+func (mt *MaskTarget) MakePicture(p Picture) TargetPicture {
+	return &wrappedPicture{
+		pic:   mt.dst.MakePicture(p),
+		owner: mt,
+	}
+}
+
+// SetMatrix sets a Matrix of the wrapped Target, if it's a BasicTarget. Otherwise it does nothing.
+func (mt *MaskTarget) SetMatrix(m Matrix) {
+	if bt, ok := mt.dst.(BasicTarget); ok {
+		bt.SetMatrix(m)
+	}
+}
+
+// SetColorMask sets a color mask of the wrapped Target, if it's a BasicTarget. Otherwise it does
+// nothing.
+func (mt *MaskTarget) SetColorMask(c color.Color) {
+	if bt, ok := mt.dst.(BasicTarget); ok {
+		bt.SetColorMask(c)
+	}
+}
+
+// maskVertex has the same underlying type as the elements of TrianglesData.
+type maskVertex struct {
+	Position  Vec
+	Color     RGBA
+	Picture   Vec
+	Intensity float64
+}
+
+func lerpMaskVertex(a, b maskVertex, t float64) maskVertex {
+	return maskVertex{
+		Position:  Lerp(a.Position, b.Position, t),
+		Color:     LerpRGBA(a.Color, b.Color, t),
+		Picture:   Lerp(a.Picture, b.Picture, t),
+		Intensity: a.Intensity + (b.Intensity-a.Intensity)*t,
+	}
+}
+
+// clip appends the part of the triangle a, b, c covered by the mask to out, as a fan of
+// triangles. The buffers are reused between calls to avoid allocations.
+func (mt *MaskTarget) clip(out *TrianglesData, a, b, c maskVertex, poly, next []maskVertex) ([]maskVertex, []maskVertex) {
+	poly = append(poly[:0], a, b, c)
+	if mt.sign == 0 {
+		return poly, next
+	}
+
+	for i := range mt.mask {
+		if len(poly) == 0 {
+			break
+		}
+		ma, mb := mt.mask[i], mt.mask[(i+1)%len(mt.mask)]
+		edge := ma.To(mb)
+		dist := func(u Vec) float64 {
+			return edge.Cross(ma.To(u)) * mt.sign
+		}
+
+		next = next[:0]
+		for j := range poly {
+			p, q := poly[j], poly[(j+1)%len(poly)]
+			dp, dq := dist(p.Position), dist(q.Position)
+			if dp >= 0 {
+				next = append(next, p)
+			}
+			if (dp < 0 && dq > 0) || (dp > 0 && dq < 0) {
+				next = append(next, lerpMaskVertex(p, q, dp/(dp-dq)))
+			}
+		}
+		poly, next = next, poly
+	}
+
+	for i := 1; i+1 < len(poly); i++ {
+		*out = append(*out, poly[0], poly[i], poly[i+1])
+	}
+	return poly, next
+}
+
+// clipAll appends the parts of all triangles of src covered by the mask to out.
+func (mt *MaskTarget) clipAll(out, src *TrianglesData) {
+	var poly, next []maskVertex
+	for i := 0; i+2 < src.Len(); i += 3 {
+		poly, next = mt.clip(
+			out,
+			maskVertex((*src)[i]),
+			maskVertex((*src)[i+1]),
+			maskVertex((*src)[i+2]),
+			poly, next,
+		)
+	}
+}
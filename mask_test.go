@@ -0,0 +1,78 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestMaskTarget_Draw(t *testing.T) {
+	square := pixel.MakeTrianglesData(6)
+	for i, u := range []pixel.Vec{
+		pixel.V(0, 0), pixel.V(4, 0), pixel.V(4, 4),
+		pixel.V(0, 0), pixel.V(4, 4), pixel.V(0, 4),
+	} {
+		(*square)[i].Position = u
+	}
+	red := pixel.RGB(1, 0, 0)
+	square.SetAllColors(red)
+
+	tests := []struct {
+		name    string
+		mask    pixel.Polygon
+		inside  []pixel.Vec
+		outside []pixel.Vec
+	}{
+		{
+			name:    "Rectangle",
+			mask:    pixel.Polygon{pixel.V(2, 1), pixel.V(2, 3), pixel.V(6, 3), pixel.V(6, 1)},
+			inside:  []pixel.Vec{pixel.V(2.5, 1.5), pixel.V(3.5, 2.5)},
+			outside: []pixel.Vec{pixel.V(1.5, 1.5), pixel.V(2.5, 0.5), pixel.V(2.5, 3.5), pixel.V(4.5, 1.5)},
+		},
+		{
+			name:    "Counter-clockwise triangle",
+			mask:    pixel.Polygon{pixel.V(0, 0), pixel.V(4, 0), pixel.V(0, 4)},
+			inside:  []pixel.Vec{pixel.V(0.5, 0.5), pixel.V(2.5, 0.5), pixel.V(0.5, 2.5)},
+			outside: []pixel.Vec{pixel.V(3.5, 3.5), pixel.V(2.5, 2.5)},
+		},
+		{
+			name:    "No overlap",
+			mask:    pixel.Polygon{pixel.V(5, 5), pixel.V(8, 5), pixel.V(8, 8), pixel.V(5, 8)},
+			outside: []pixel.Vec{pixel.V(0.5, 0.5), pixel.V(3.5, 3.5), pixel.V(5.5, 5.5)},
+		},
+		{
+			name:    "Zero area",
+			mask:    pixel.Polygon{pixel.V(0, 0), pixel.V(4, 4)},
+			outside: []pixel.Vec{pixel.V(0.5, 0.5), pixel.V(1.5, 1.5)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+			mt := pixel.NewMaskTarget(it, tt.mask)
+			mt.MakeTriangles(square).Draw()
+
+			for _, at := range tt.inside {
+				if got := it.Color(at); got != red {
+					t.Errorf("Color(%v) = %v, want %v", at, got, red)
+				}
+			}
+			for _, at := range tt.outside {
+				if got := it.Color(at); got != (pixel.RGBA{}) {
+					t.Errorf("Color(%v) = %v, want transparent", at, got)
+				}
+			}
+		})
+	}
+}
+
+func TestNewMaskTarget_NotConvex(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewMaskTarget() did not panic on a non-convex mask")
+		}
+	}()
+	pixel.NewMaskTarget(pixel.NewImageTarget(pixel.R(0, 0, 8, 8)), pixel.Polygon{
+		pixel.V(0, 0), pixel.V(4, 0), pixel.V(1, 1), pixel.V(0, 4),
+	})
+}
@@ -1,10 +1,12 @@
 package pixel_test
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 
 	"github.com/faiface/pixel"
+	"github.com/stretchr/testify/assert"
 )
 
 func BenchmarkMatrix(b *testing.B) {
@@ -61,3 +63,77 @@ func BenchmarkMatrix(b *testing.B) {
 		}
 	})
 }
+
+func TestCompose(t *testing.T) {
+	local := pixel.IM.Rotated(pixel.ZV, math.Pi/2)
+	parent := pixel.IM.Moved(pixel.V(10, 0))
+	world := pixel.Compose(local, pixel.IM.Scaled(pixel.ZV, 2), parent)
+
+	got := world.Project(pixel.V(1, 0))
+	assert.InDelta(t, 10, got.X, 1e-9)
+	assert.InDelta(t, 2, got.Y, 1e-9)
+
+	if got := pixel.Compose(); got != pixel.IM {
+		t.Errorf("Compose() = %v, want %v", got, pixel.IM)
+	}
+}
+
+func TestMatrix_Inverse(t *testing.T) {
+	tests := []struct {
+		name   string
+		m      pixel.Matrix
+		wantOK bool
+	}{
+		{name: "Identity", m: pixel.IM, wantOK: true},
+		{name: "Transformed", m: pixel.IM.ScaledXY(pixel.V(1, 2), pixel.V(3, -0.5)).Rotated(pixel.V(-4, 1), 0.7).Moved(pixel.V(5, 6)), wantOK: true},
+		{name: "Zero scale", m: pixel.IM.ScaledXY(pixel.ZV, pixel.V(0, 1)), wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv, ok := tt.m.Inverse()
+			if ok != tt.wantOK {
+				t.Fatalf("Matrix.Inverse() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			for _, u := range []pixel.Vec{pixel.ZV, pixel.V(1, 0), pixel.V(-3.5, 7)} {
+				got := inv.Project(tt.m.Project(u))
+				assert.InDelta(t, u.X, got.X, 1e-9)
+				assert.InDelta(t, u.Y, got.Y, 1e-9)
+				want := tt.m.Unproject(u)
+				got = inv.Project(u)
+				assert.InDelta(t, want.X, got.X, 1e-9)
+				assert.InDelta(t, want.Y, got.Y, 1e-9)
+			}
+		})
+	}
+}
+
+func TestMatrix_ProjectSlice(t *testing.T) {
+	m := pixel.IM.Rotated(pixel.ZV, math.Pi/2).Moved(pixel.V(1, 2))
+	src := []pixel.Vec{pixel.V(1, 0), pixel.V(0, 1), pixel.V(3, 4)}
+
+	dst := make([]pixel.Vec, len(src)+1)
+	m.ProjectSlice(dst, src)
+	for i := range src {
+		if dst[i] != m.Project(src[i]) {
+			t.Errorf("ProjectSlice() [%d] = %v, want %v", i, dst[i], m.Project(src[i]))
+		}
+	}
+
+	inPlace := append([]pixel.Vec(nil), src...)
+	m.ProjectSlice(inPlace, inPlace)
+	for i := range src {
+		if inPlace[i] != dst[i] {
+			t.Errorf("ProjectSlice() in place [%d] = %v, want %v", i, inPlace[i], dst[i])
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ProjectSlice() did not panic with a short dst")
+		}
+	}()
+	m.ProjectSlice(dst[:1], src)
+}
@@ -0,0 +1,96 @@
+package pixel
+
+// NinePatch is a rectangular Picture split into nine parts by four margins, which can be drawn
+// stretched to any rectangle without distorting it's borders. This is useful for scalable UI
+// panels, buttons and similar.
+//
+// The four corners keep their size, the left and right edges are only stretched vertically, the
+// top and bottom edges only horizontally and the center is stretched both ways. All nine parts are
+// drawn at once as a single Triangles.
+//
+// If the bounds are smaller than the sum of the opposite margins, the margins are shrunk
+// proportionally to fit and the edges and the center between them disappear.
+type NinePatch struct {
+	pic                      Picture
+	left, right, top, bottom float64
+	bounds                   Rect
+
+	tri *TrianglesData
+	d   Drawer
+}
+
+var _ Drawable = (*NinePatch)(nil)
+
+// NewNinePatch creates a NinePatch from the whole supplied Picture with the given margins. The
+// margins are measured from the corresponding sides of the Picture's bounds.
+//
+// The initial bounds of the NinePatch are the bounds of the Picture.
+func NewNinePatch(pic Picture, left, right, top, bottom float64) *NinePatch {
+	tri := MakeTrianglesData(9 * 6)
+	np := &NinePatch{
+		pic:    pic,
+		left:   left,
+		right:  right,
+		top:    top,
+		bottom: bottom,
+		tri:    tri,
+		d:      Drawer{Triangles: tri, Picture: pic},
+	}
+	np.SetBounds(pic.Bounds())
+	return np
+}
+
+// SetBounds sets the rectangle the NinePatch is stretched to.
+func (np *NinePatch) SetBounds(r Rect) {
+	r = r.Norm()
+	if r != np.bounds {
+		np.bounds = r
+		np.calcData()
+	}
+}
+
+// Bounds returns the rectangle the NinePatch is stretched to.
+func (np *NinePatch) Bounds() Rect {
+	return np.bounds
+}
+
+// Draw draws the NinePatch stretched to it's bounds onto the provided Target.
+func (np *NinePatch) Draw(t Target) {
+	np.d.Draw(t)
+}
+
+func (np *NinePatch) calcData() {
+	var (
+		frame = np.pic.Bounds()
+		xs    = ninePatchSplit(np.bounds.Min.X, np.bounds.Max.X, np.left, np.right)
+		ys    = ninePatchSplit(np.bounds.Min.Y, np.bounds.Max.Y, np.bottom, np.top)
+		pxs   = ninePatchSplit(frame.Min.X, frame.Max.X, np.left, np.right)
+		pys   = ninePatchSplit(frame.Min.Y, frame.Max.Y, np.bottom, np.top)
+	)
+
+	i := 0
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			for _, corner := range [...][2]int{{0, 0}, {1, 0}, {1, 1}, {0, 0}, {1, 1}, {0, 1}} {
+				cx, cy := x+corner[0], y+corner[1]
+				(*np.tri)[i].Position = V(xs[cx], ys[cy])
+				(*np.tri)[i].Picture = V(pxs[cx], pys[cy])
+				(*np.tri)[i].Intensity = 1
+				i++
+			}
+		}
+	}
+
+	np.d.Dirty()
+}
+
+// ninePatchSplit returns the coordinates splitting the range from min to max by the two margins.
+// The margins are shrunk proportionally if they don't fit into the range.
+func ninePatchSplit(min, max, marginMin, marginMax float64) [4]float64 {
+	if sum := marginMin + marginMax; sum > max-min && sum > 0 {
+		scale := (max - min) / sum
+		marginMin *= scale
+		marginMax *= scale
+	}
+	return [4]float64{min, min + marginMin, max - marginMax, max}
+}
@@ -0,0 +1,86 @@
+package pixel_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestNinePatch_Draw(t *testing.T) {
+	var (
+		red    = pixel.RGB(1, 0, 0)
+		green  = pixel.RGB(0, 1, 0)
+		blue   = pixel.RGB(0, 0, 1)
+		yellow = pixel.RGB(1, 1, 0)
+	)
+
+	// 4x4 picture with a differently colored 2x2 quadrant in each corner
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			c := color.RGBA{A: 0xff}
+			if x >= 2 {
+				c.G = 0xff
+			}
+			if y >= 2 {
+				c.R = 0xff
+			}
+			if x < 2 && y < 2 {
+				c.B = 0xff
+			}
+			pic.Pix[pic.Index(pixel.V(float64(x), float64(y)))] = c
+		}
+	}
+	// bottom-left blue, bottom-right green, top-left red, top-right yellow
+
+	tests := []struct {
+		name   string
+		margin float64
+		bounds pixel.Rect
+		want   map[pixel.Vec]pixel.RGBA
+	}{
+		{
+			name:   "Stretched",
+			margin: 1,
+			bounds: pixel.R(0, 0, 8, 6),
+			want: map[pixel.Vec]pixel.RGBA{
+				pixel.V(0.5, 0.5): blue,
+				pixel.V(7.5, 0.5): green,
+				pixel.V(0.5, 5.5): red,
+				pixel.V(7.5, 5.5): yellow,
+				pixel.V(2.5, 1.5): blue,
+				pixel.V(5.5, 4.5): yellow,
+			},
+		},
+		{
+			name:   "Smaller than corners",
+			margin: 2,
+			bounds: pixel.R(0, 0, 2, 2),
+			want: map[pixel.Vec]pixel.RGBA{
+				pixel.V(0.5, 0.5): blue,
+				pixel.V(1.5, 0.5): green,
+				pixel.V(0.5, 1.5): red,
+				pixel.V(1.5, 1.5): yellow,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			np := pixel.NewNinePatch(pic, tt.margin, tt.margin, tt.margin, tt.margin)
+			np.SetBounds(tt.bounds)
+
+			it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+			np.Draw(it)
+
+			for at, want := range tt.want {
+				if got := it.Color(at); got != want {
+					t.Errorf("Color(%v) = %v, want %v", at, got, want)
+				}
+			}
+			if got := it.Color(pixel.V(0.5, 7.5)); got != (pixel.RGBA{}) {
+				t.Errorf("Color outside of bounds = %v, want transparent", got)
+			}
+		})
+	}
+}
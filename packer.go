@@ -0,0 +1,80 @@
+package pixel
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// RectPacker computes placements of rectangles of various sizes inside a single rectangle without
+// overlaps, such as when building a texture atlas from many small pictures.
+//
+// Add the sizes of all rectangles first, then call Pack:
+//
+//   var packer pixel.RectPacker
+//   hero := packer.Add(32, 48)
+//   tree := packer.Add(64, 64)
+//   placements, size, err := packer.Pack(1024)
+//   // placements[hero] is where to put the hero inside the atlas of the given size
+//
+// The zero value of RectPacker is ready to use.
+type RectPacker struct {
+	sizes []Vec
+}
+
+// Add adds a rectangle of the given size to the RectPacker and returns it's id. The id is the index
+// of the rectangle's placement returned by Pack.
+func (rp *RectPacker) Add(w, h float64) (id int) {
+	rp.sizes = append(rp.sizes, V(w, h))
+	return len(rp.sizes) - 1
+}
+
+// Pack computes the placements of all added rectangles inside a rectangle of at most the given
+// width. The placements are indexed by the ids returned from Add and the size of the smallest
+// rectangle containing all of them, anchored at the origin, is returned along with them.
+//
+// Rectangles are placed on shelves, from the tallest to the shortest, left to right and bottom to
+// top. An error is returned if any rectangle is wider than maxWidth or has a negative size.
+func (rp *RectPacker) Pack(maxWidth float64) (placements []Rect, size Vec, err error) {
+	order := make(idsByHeight, len(rp.sizes))
+	for id, s := range rp.sizes {
+		if s.X < 0 || s.Y < 0 {
+			return nil, ZV, fmt.Errorf("(%T).Pack: rectangle %d has negative size %v", rp, id, s)
+		}
+		if s.X > maxWidth {
+			return nil, ZV, fmt.Errorf("(%T).Pack: rectangle %d of width %v is wider than %v", rp, id, s.X, maxWidth)
+		}
+		order[id] = struct {
+			id int
+			h  float64
+		}{id, s.Y}
+	}
+	sort.Stable(order)
+
+	placements = make([]Rect, len(rp.sizes))
+	var pos Vec
+	shelf := 0.0
+	for _, o := range order {
+		s := rp.sizes[o.id]
+		if pos.X+s.X > maxWidth {
+			pos = V(0, pos.Y+shelf)
+			shelf = 0
+		}
+		placements[o.id] = Rect{Min: pos, Max: pos.Add(s)}
+		size.X = math.Max(size.X, pos.X+s.X)
+		size.Y = math.Max(size.Y, pos.Y+s.Y)
+		pos.X += s.X
+		shelf = math.Max(shelf, s.Y)
+	}
+
+	return placements, size, nil
+}
+
+type idsByHeight []struct {
+	id int
+	h  float64
+}
+
+func (ids idsByHeight) Len() int           { return len(ids) }
+func (ids idsByHeight) Swap(i, j int)      { ids[i], ids[j] = ids[j], ids[i] }
+func (ids idsByHeight) Less(i, j int) bool { return ids[i].h > ids[j].h }
@@ -0,0 +1,79 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestRectPacker_Pack(t *testing.T) {
+	tests := []struct {
+		name     string
+		sizes    []pixel.Vec
+		maxWidth float64
+		want     []pixel.Rect
+		wantSize pixel.Vec
+		wantErr  bool
+	}{
+		{
+			name:     "Single shelf",
+			sizes:    []pixel.Vec{pixel.V(10, 5), pixel.V(20, 10)},
+			maxWidth: 100,
+			want:     []pixel.Rect{pixel.R(20, 0, 30, 5), pixel.R(0, 0, 20, 10)},
+			wantSize: pixel.V(30, 10),
+		},
+		{
+			name:     "Multiple shelves",
+			sizes:    []pixel.Vec{pixel.V(30, 10), pixel.V(30, 20), pixel.V(30, 10), pixel.V(50, 5)},
+			maxWidth: 64,
+			want: []pixel.Rect{
+				pixel.R(30, 0, 60, 10),
+				pixel.R(0, 0, 30, 20),
+				pixel.R(0, 20, 30, 30),
+				pixel.R(0, 30, 50, 35),
+			},
+			wantSize: pixel.V(60, 35),
+		},
+		{
+			name:     "No rectangles",
+			maxWidth: 64,
+			want:     []pixel.Rect{},
+			wantSize: pixel.ZV,
+		},
+		{
+			name:     "Too wide",
+			sizes:    []pixel.Vec{pixel.V(10, 10), pixel.V(65, 10)},
+			maxWidth: 64,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var packer pixel.RectPacker
+			for i, s := range tt.sizes {
+				if id := packer.Add(s.X, s.Y); id != i {
+					t.Fatalf("Add() = %d, want %d", id, i)
+				}
+			}
+
+			got, size, err := packer.Pack(tt.maxWidth)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Pack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if size != tt.wantSize {
+				t.Errorf("Pack() size = %v, want %v", size, tt.wantSize)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Pack() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("placement %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
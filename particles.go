@@ -0,0 +1,160 @@
+package pixel
+
+import "math/rand"
+
+// Particle is a single particle of a ParticleSystem.
+type Particle struct {
+	Pos, Vel Vec
+	Color    RGBA
+
+	// Life is the remaining time of the Particle, MaxLife is the time it was emitted with.
+	Life, MaxLife float64
+}
+
+// ParticleSystem is a Drawable pool of particles, which move with their velocities, fade out over
+// their life and die. Each Particle is drawn as a square of the color of the Particle.
+//
+// Emit new particles, Update the system every frame and draw it onto a Target. Drawing onto a
+// Batch allows for drawing many ParticleSystems at once:
+//
+//   ps := pixel.NewParticleSystem()
+//   ps.MinVelocity, ps.MaxVelocity = pixel.V(-50, 50), pixel.V(50, 150)
+//   ps.Gravity = pixel.V(0, -200)
+//
+//   ps.Emit(10, pos)
+//   ps.Update(dt)
+//   ps.Draw(win)
+//
+// The properties of emitted particles are picked randomly from the ranges given by the Min and Max
+// fields, each component independently. Changing the fields only affects the particles emitted
+// later. Dead particles are recycled, so a ParticleSystem with a stable number of particles doesn't
+// allocate.
+type ParticleSystem struct {
+	// MinVelocity and MaxVelocity are the corners of the rectangle velocities are picked from.
+	MinVelocity, MaxVelocity Vec
+
+	// MinColor and MaxColor are the range of the colors, which are interpolated between them.
+	MinColor, MaxColor RGBA
+
+	// MinLife and MaxLife are the range of the life of the particles in seconds.
+	MinLife, MaxLife float64
+
+	// Gravity is the acceleration applied to all particles, including already emitted ones.
+	Gravity Vec
+
+	// Size is the side of the square of each Particle.
+	Size float64
+
+	particles []Particle
+	tri       *TrianglesData
+	d         Drawer
+}
+
+var _ Drawable = (*ParticleSystem)(nil)
+
+// NewParticleSystem creates an empty ParticleSystem of white particles of size 1, which stand still
+// for 1 second.
+func NewParticleSystem() *ParticleSystem {
+	tri := &TrianglesData{}
+	return &ParticleSystem{
+		MinColor: Alpha(1),
+		MaxColor: Alpha(1),
+		MinLife:  1,
+		MaxLife:  1,
+		Size:     1,
+		tri:      tri,
+		d:        Drawer{Triangles: tri},
+	}
+}
+
+// Emit adds n new particles at the origin.
+func (ps *ParticleSystem) Emit(n int, origin Vec) {
+	lerp := func(a, b float64) float64 {
+		return a + (b-a)*rand.Float64()
+	}
+	for i := 0; i < n; i++ {
+		life := lerp(ps.MinLife, ps.MaxLife)
+		ps.particles = append(ps.particles, Particle{
+			Pos: origin,
+			Vel: V(
+				lerp(ps.MinVelocity.X, ps.MaxVelocity.X),
+				lerp(ps.MinVelocity.Y, ps.MaxVelocity.Y),
+			),
+			Color: RGBA{
+				R: lerp(ps.MinColor.R, ps.MaxColor.R),
+				G: lerp(ps.MinColor.G, ps.MaxColor.G),
+				B: lerp(ps.MinColor.B, ps.MaxColor.B),
+				A: lerp(ps.MinColor.A, ps.MaxColor.A),
+			},
+			Life:    life,
+			MaxLife: life,
+		})
+	}
+}
+
+// Update moves all particles by dt seconds forward in time and removes the dead ones.
+func (ps *ParticleSystem) Update(dt float64) {
+	for i := 0; i < len(ps.particles); {
+		p := &ps.particles[i]
+		p.Life -= dt
+		if p.Life <= 0 {
+			// the last particle takes the place of the dead one, the order does not matter
+			last := len(ps.particles) - 1
+			ps.particles[i] = ps.particles[last]
+			ps.particles = ps.particles[:last]
+			continue
+		}
+		p.Vel = p.Vel.Add(ps.Gravity.Scaled(dt))
+		p.Pos = p.Pos.Add(p.Vel.Scaled(dt))
+		i++
+	}
+}
+
+// Particles returns the living particles. The slice is only valid until the next Emit or Update and
+// may be modified to adjust the particles.
+func (ps *ParticleSystem) Particles() []Particle {
+	return ps.particles
+}
+
+// Len returns the number of living particles.
+func (ps *ParticleSystem) Len() int {
+	return len(ps.particles)
+}
+
+// Clear removes all particles.
+func (ps *ParticleSystem) Clear() {
+	ps.particles = ps.particles[:0]
+}
+
+// Draw draws all living particles onto the provided Target. Each Particle's color is multiplied by
+// the fraction of it's remaining life, so the particles fade out. Particles with no life left, such
+// as those emitted with zero life, are not drawn.
+func (ps *ParticleSystem) Draw(t Target) {
+	ps.tri.SetLen(0)
+	half := ps.Size / 2
+	for _, p := range ps.particles {
+		if p.Life <= 0 {
+			continue
+		}
+		col := p.Color
+		if p.Life < p.MaxLife {
+			col = col.Scaled(p.Life / p.MaxLife)
+		}
+		corners := [...]Vec{
+			p.Pos.Add(V(-half, -half)),
+			p.Pos.Add(V(half, -half)),
+			p.Pos.Add(V(half, half)),
+			p.Pos.Add(V(-half, -half)),
+			p.Pos.Add(V(half, half)),
+			p.Pos.Add(V(-half, half)),
+		}
+		off := ps.tri.Len()
+		ps.tri.SetLen(off + 6)
+		for j, u := range corners {
+			(*ps.tri)[off+j].Position = u
+			(*ps.tri)[off+j].Color = col
+		}
+	}
+	ps.d.Dirty()
+	ps.d.Draw(t)
+}
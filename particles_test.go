@@ -0,0 +1,71 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParticleSystem(t *testing.T) {
+	ps := pixel.NewParticleSystem()
+	ps.MinVelocity, ps.MaxVelocity = pixel.V(10, 0), pixel.V(10, 0)
+	ps.MinColor, ps.MaxColor = pixel.RGB(1, 0, 0), pixel.RGB(1, 0, 0)
+	ps.Gravity = pixel.V(0, -10)
+	ps.Size = 2
+
+	ps.Emit(3, pixel.V(5, 5))
+	ps.MinLife, ps.MaxLife = 2, 2
+	ps.Emit(1, pixel.V(5, 5))
+	if ps.Len() != 4 {
+		t.Fatalf("ParticleSystem.Len() = %d, want 4", ps.Len())
+	}
+
+	ps.Update(0.5)
+	for _, p := range ps.Particles() {
+		assert.InDelta(t, 10, p.Pos.X, 1e-9)
+		assert.InDelta(t, 2.5, p.Pos.Y, 1e-9)
+		assert.InDelta(t, -5, p.Vel.Y, 1e-9)
+	}
+
+	// the particles fade out over their life, three are at half and one is at three quarters of it,
+	// all drawn over each other
+	it := pixel.NewImageTarget(pixel.R(0, 0, 16, 16))
+	ps.Draw(it)
+	if got, want := it.Color(pixel.V(10, 2.5)), pixel.RGB(1, 0, 0).Scaled(1-0.5*0.5*0.5*0.25); !rgbaNear(got, want) {
+		t.Errorf("ParticleSystem.Draw() color = %v, want %v", got, want)
+	}
+
+	ps.Update(0.6)
+	if ps.Len() != 1 {
+		t.Fatalf("ParticleSystem.Len() after the short lived particles died = %d, want 1", ps.Len())
+	}
+	if p := ps.Particles()[0]; p.MaxLife != 2 {
+		t.Errorf("surviving Particle has MaxLife %v, want 2", p.MaxLife)
+	}
+
+	// dead particles are recycled
+	before := cap(ps.Particles())
+	ps.Emit(3, pixel.ZV)
+	if cap(ps.Particles()) != before {
+		t.Errorf("ParticleSystem.Emit() reallocated the particles")
+	}
+
+	ps.Clear()
+	if ps.Len() != 0 {
+		t.Errorf("ParticleSystem.Len() after Clear = %d, want 0", ps.Len())
+	}
+}
+
+func TestParticleSystem_DrawZeroLife(t *testing.T) {
+	ps := pixel.NewParticleSystem()
+	ps.MinLife, ps.MaxLife = 0, 0
+	ps.Size = 2
+	ps.Emit(2, pixel.V(1, 1))
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 2, 2))
+	ps.Draw(it)
+	if got := it.Color(pixel.V(0.5, 0.5)); got != (pixel.RGBA{}) {
+		t.Errorf("particles with zero life drew %v, want %v", got, pixel.RGBA{})
+	}
+}
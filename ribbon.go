@@ -0,0 +1,138 @@
+package pixel
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// ribbonMiterLimit is the maximum length of a miter joint relative to half of the width. Sharper
+// bends are cut to this length to avoid long spikes.
+const ribbonMiterLimit = 4
+
+// Ribbon returns TrianglesData of a ribbon of the given width along the path through the supplied
+// points, filled with a single color. Consecutive segments are connected by miter joints, which are
+// limited in length at very sharp bends.
+//
+// This is useful for motion trails:
+//
+//   d := pixel.Drawer{Triangles: pixel.Ribbon(trail, 4, colornames.White)}
+//   d.Draw(win)
+//
+// Repeated consecutive points are ignored. Fewer than two different points produce empty
+// TrianglesData.
+func Ribbon(points []Vec, width float64, col color.Color) *TrianglesData {
+	widths := make([]float64, len(points))
+	for i := range widths {
+		widths[i] = width
+	}
+	return TaperedRibbon(points, widths, col)
+}
+
+// TaperedRibbon is like Ribbon, but the width of the ribbon at each point is given separately and
+// interpolated linearly between them. This allows for trails, which fade out to a point.
+//
+// The number of widths must be the same as the number of points, otherwise this function panics.
+func TaperedRibbon(points []Vec, widths []float64, col color.Color) *TrianglesData {
+	if len(widths) != len(points) {
+		panic(fmt.Errorf("TaperedRibbon: %d widths for %d points", len(widths), len(points)))
+	}
+	colors := make([]RGBA, len(points))
+	rgba := ToRGBA(col)
+	for i := range colors {
+		colors[i] = rgba
+	}
+	return ribbon(points, widths, colors)
+}
+
+// LineStrip returns TrianglesData of a connected line of the given thickness through the supplied
+// points, just like Ribbon. Each point can have it's own color, which is interpolated along the
+// segments to the neighbouring points, so this is well suited for plots:
+//
+//   d := pixel.Drawer{Triangles: pixel.LineStrip(samples, 2, colors)}
+//   d.Draw(win)
+//
+// There must be at least two points and either one color for all points, or a color for each
+// point, otherwise this function panics.
+func LineStrip(points []Vec, thickness float64, colors []color.Color) *TrianglesData {
+	if len(points) < 2 {
+		panic(fmt.Errorf("LineStrip: %d points, need at least 2", len(points)))
+	}
+	if len(colors) != 1 && len(colors) != len(points) {
+		panic(fmt.Errorf("LineStrip: %d colors for %d points", len(colors), len(points)))
+	}
+	var (
+		widths = make([]float64, len(points))
+		rgbas  = make([]RGBA, len(points))
+	)
+	for i := range points {
+		widths[i] = thickness
+		if len(colors) == 1 {
+			rgbas[i] = ToRGBA(colors[0])
+		} else {
+			rgbas[i] = ToRGBA(colors[i])
+		}
+	}
+	return ribbon(points, widths, rgbas)
+}
+
+// ribbon builds the triangles of a ribbon with a width and a color at each point.
+func ribbon(points []Vec, widths []float64, colors []RGBA) *TrianglesData {
+	// drop repeated points, they have no direction
+	var (
+		path  = make([]Vec, 0, len(points))
+		halfW = make([]float64, 0, len(points))
+		cols  = make([]RGBA, 0, len(points))
+	)
+	for i, u := range points {
+		if len(path) > 0 && path[len(path)-1] == u {
+			continue
+		}
+		path = append(path, u)
+		halfW = append(halfW, widths[i]/2)
+		cols = append(cols, colors[i])
+	}
+	if len(path) < 2 {
+		return &TrianglesData{}
+	}
+
+	// left and right side of the ribbon at each point
+	left, right := make([]Vec, len(path)), make([]Vec, len(path))
+	for i := range path {
+		var offset Vec
+		switch i {
+		case 0:
+			offset = path[0].To(path[1]).Normal().Unit()
+		case len(path) - 1:
+			offset = path[i-1].To(path[i]).Normal().Unit()
+		default:
+			in := path[i-1].To(path[i]).Normal().Unit()
+			out := path[i].To(path[i+1]).Normal().Unit()
+			miter := in.Add(out)
+			if miter == ZV {
+				// the path turns back, there's no sensible joint
+				miter = in
+			}
+			miter = miter.Unit()
+			length := 1 / miter.Dot(in)
+			if length > ribbonMiterLimit {
+				length = ribbonMiterLimit
+			}
+			offset = miter.Scaled(length)
+		}
+		left[i] = path[i].Add(offset.Scaled(halfW[i]))
+		right[i] = path[i].Sub(offset.Scaled(halfW[i]))
+	}
+
+	tri := MakeTrianglesData(6 * (len(path) - 1))
+	for i := 0; i+1 < len(path); i++ {
+		corners := [...]struct {
+			side []Vec
+			k    int
+		}{{left, i}, {right, i}, {right, i + 1}, {left, i}, {right, i + 1}, {left, i + 1}}
+		for j, c := range corners {
+			(*tri)[6*i+j].Position = c.side[c.k]
+			(*tri)[6*i+j].Color = cols[c.k]
+		}
+	}
+	return tri
+}
@@ -0,0 +1,121 @@
+package pixel_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/faiface/pixel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRibbon(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []pixel.Vec
+		width    float64
+		wantLen  int
+		wantArea float64
+	}{
+		{
+			name:     "Straight",
+			points:   []pixel.Vec{pixel.V(0, 0), pixel.V(10, 0)},
+			width:    2,
+			wantLen:  6,
+			wantArea: 20,
+		},
+		{
+			name:     "Right angle",
+			points:   []pixel.Vec{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10)},
+			width:    2,
+			wantLen:  12,
+			wantArea: 40, // the miter adds as much on the outside as it cuts on the inside
+		},
+		{
+			name:     "Repeated points",
+			points:   []pixel.Vec{pixel.V(0, 0), pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 0)},
+			width:    2,
+			wantLen:  6,
+			wantArea: 20,
+		},
+		{
+			name:   "One point",
+			points: []pixel.Vec{pixel.V(0, 0)},
+			width:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pixel.Ribbon(tt.points, tt.width, pixel.RGB(1, 0, 0))
+			if got.Len() != tt.wantLen {
+				t.Fatalf("Len() = %d, want %d", got.Len(), tt.wantLen)
+			}
+			area := 0.0
+			for i := 0; i < got.Len(); i += 3 {
+				a, b, c := got.Position(i), got.Position(i+1), got.Position(i+2)
+				area += math.Abs(a.To(b).Cross(a.To(c))) / 2
+			}
+			assert.InDelta(t, tt.wantArea, area, 1e-9)
+		})
+	}
+
+	// the outer miter joint of a right angle lies on the diagonal
+	got := pixel.Ribbon([]pixel.Vec{pixel.V(0, 0), pixel.V(10, 0), pixel.V(10, 10)}, 2, pixel.RGB(1, 0, 0))
+	corner := got.Position(2)
+	assert.InDelta(t, 11, corner.X, 1e-9)
+	assert.InDelta(t, -1, corner.Y, 1e-9)
+}
+
+func TestTaperedRibbon(t *testing.T) {
+	got := pixel.TaperedRibbon([]pixel.Vec{pixel.V(0, 0), pixel.V(10, 0)}, []float64{4, 0}, pixel.RGB(1, 0, 0))
+	area := 0.0
+	for i := 0; i < got.Len(); i += 3 {
+		a, b, c := got.Position(i), got.Position(i+1), got.Position(i+2)
+		area += math.Abs(a.To(b).Cross(a.To(c))) / 2
+	}
+	assert.InDelta(t, 20, area, 1e-9)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("TaperedRibbon() did not panic on mismatched widths")
+		}
+	}()
+	pixel.TaperedRibbon([]pixel.Vec{pixel.V(0, 0), pixel.V(10, 0)}, []float64{1}, pixel.RGB(1, 0, 0))
+}
+
+func TestLineStrip(t *testing.T) {
+	points := []pixel.Vec{pixel.V(0, 0), pixel.V(10, 0), pixel.V(20, 0)}
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+
+	got := pixel.LineStrip(points, 2, []color.Color{red, blue, red})
+	if got.Len() != 12 {
+		t.Fatalf("LineStrip().Len() = %d, want 12", got.Len())
+	}
+	for i := 0; i < got.Len(); i++ {
+		want := red
+		if got.Position(i).X == 10 {
+			want = blue
+		}
+		if got.Color(i) != want {
+			t.Errorf("LineStrip() vertex %d at %v has color %v, want %v", i, got.Position(i), got.Color(i), want)
+		}
+	}
+
+	single := pixel.LineStrip(points, 2, []color.Color{blue})
+	for i := 0; i < single.Len(); i++ {
+		if single.Color(i) != blue {
+			t.Errorf("LineStrip() with a single color has vertex color %v, want %v", single.Color(i), blue)
+		}
+	}
+
+	for _, colors := range [][]color.Color{nil, {red, blue}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("LineStrip() did not panic with %d colors for %d points", len(colors), len(points))
+				}
+			}()
+			pixel.LineStrip(points, 2, colors)
+		}()
+	}
+}
@@ -0,0 +1,82 @@
+package pixel
+
+import (
+	"image/color"
+	"math"
+)
+
+// Shadow is a Drawable, which draws another Drawable with a drop shadow under it. The shadow is the
+// Drawable moved by an offset and multiplied by the shadow color, so a semi-transparent black color
+// gives a dark silhouette, even for Drawables with Pictures.
+//
+//   sprite := pixel.DrawableFunc(func(t pixel.Target) { s.Draw(t, mat) })
+//   shadowed := pixel.NewShadow(sprite, pixel.V(4, -4), pixel.RGBA{A: 0.5})
+//   shadowed.Draw(win)
+//
+// If Softness is greater than zero, the shadow is drawn in Passes passes with offsets spread around
+// a circle of radius Softness, each with a fraction of the shadow color, which blurs it's edges.
+// Where all passes overlap, the shadow has the full shadow color.
+//
+// Shadow is a type rather than a function, because it caches data for each Target it's drawn onto,
+// just like a Group does. Create it once and draw it repeatedly.
+type Shadow struct {
+	// Offset is the offset of the shadow from the Drawable.
+	Offset Vec
+
+	// Color is the color the shadow is multiplied by. If it's nil, the shadow is opaque black.
+	Color color.Color
+
+	// Softness is the radius of the blur of the shadow's edges. Zero means a sharp shadow.
+	Softness float64
+
+	// Passes is the number of passes of a soft shadow. NewShadow sets it to 8, zero or less draws a
+	// single pass, just like a sharp shadow.
+	Passes int
+
+	d     Drawable
+	group *Group
+}
+
+var _ Drawable = (*Shadow)(nil)
+
+// NewShadow creates a Shadow of the supplied Drawable with the given offset and color and a sharp
+// edge.
+func NewShadow(d Drawable, offset Vec, col color.Color) *Shadow {
+	return &Shadow{
+		Offset: offset,
+		Color:  col,
+		Passes: 8,
+		d:      d,
+		group:  NewGroup(d),
+	}
+}
+
+// Draw draws the shadow and then the Drawable itself onto the provided Target.
+func (s *Shadow) Draw(t Target) {
+	passes := s.Passes
+	if s.Softness == 0 || passes < 1 {
+		passes = 1
+	}
+
+	// the passes are composed over each other, so each has such alpha, that where all of them
+	// overlap, the alpha is the alpha of the shadow color
+	col := RGBA{A: 1}
+	if s.Color != nil {
+		col = ToRGBA(s.Color)
+	}
+	if passes > 1 && col.A > 0 && col.A < 1 {
+		col = col.Scaled((1 - math.Pow(1-col.A, 1/float64(passes))) / col.A)
+	}
+
+	for i := 0; i < passes; i++ {
+		offset := s.Offset
+		if passes > 1 {
+			offset = offset.Add(Unit(2 * math.Pi * float64(i) / float64(passes)).Scaled(s.Softness))
+		}
+		s.group.SetMatrix(IM.Moved(offset))
+		s.group.SetColorMask(col)
+		s.group.Draw(t)
+	}
+
+	s.d.Draw(t)
+}
@@ -0,0 +1,82 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestShadow_Draw(t *testing.T) {
+	square := &pixel.TrianglesData{
+		{Position: pixel.V(1, 1)}, {Position: pixel.V(3, 1)}, {Position: pixel.V(3, 3)},
+		{Position: pixel.V(1, 1)}, {Position: pixel.V(3, 3)}, {Position: pixel.V(1, 3)},
+	}
+	red := pixel.RGB(1, 0, 0)
+	square.SetAllColors(red)
+
+	tests := []struct {
+		name     string
+		softness float64
+		want     map[pixel.Vec]pixel.RGBA
+	}{
+		{
+			name: "Sharp",
+			want: map[pixel.Vec]pixel.RGBA{
+				pixel.V(1.5, 1.5): red,
+				pixel.V(2.5, 1.5): red,
+				pixel.V(3.5, 0.5): {A: 0.5},
+				pixel.V(0.5, 0.5): {},
+			},
+		},
+		{
+			name:     "Soft",
+			softness: 0.5,
+			want: map[pixel.Vec]pixel.RGBA{
+				pixel.V(1.5, 1.5): red,
+				pixel.V(3.5, 1.5): {A: 0.5},
+				pixel.V(0.5, 0.5): {},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shadow := pixel.NewShadow(&pixel.Drawer{Triangles: square}, pixel.V(1, -1), pixel.RGBA{A: 0.5})
+			shadow.Softness = tt.softness
+
+			it := pixel.NewImageTarget(pixel.R(0, 0, 4, 4))
+			shadow.Draw(it)
+
+			for at, want := range tt.want {
+				if got := it.Color(at); !rgbaNear(got, want) {
+					t.Errorf("Color(%v) = %v, want %v", at, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestShadow_DrawDefaults(t *testing.T) {
+	square := &pixel.TrianglesData{
+		{Position: pixel.V(1, 1)}, {Position: pixel.V(3, 1)}, {Position: pixel.V(3, 3)},
+		{Position: pixel.V(1, 1)}, {Position: pixel.V(3, 3)}, {Position: pixel.V(1, 3)},
+	}
+	square.SetAllColors(pixel.RGB(1, 0, 0))
+
+	// nil color is opaque black, no passes is a single sharp pass
+	shadow := pixel.NewShadow(&pixel.Drawer{Triangles: square}, pixel.V(1, -1), nil)
+	shadow.Softness = 0.5
+	shadow.Passes = 0
+
+	it := pixel.NewImageTarget(pixel.R(0, 0, 4, 4))
+	shadow.Draw(it)
+
+	for at, want := range map[pixel.Vec]pixel.RGBA{
+		pixel.V(3.5, 0.5): {A: 1},
+		pixel.V(3.5, 3.5): {},
+		pixel.V(1.5, 1.5): pixel.RGB(1, 0, 0),
+	} {
+		if got := it.Color(at); !rgbaNear(got, want) {
+			t.Errorf("Color(%v) = %v, want %v", at, got, want)
+		}
+	}
+}
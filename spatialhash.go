@@ -0,0 +1,167 @@
+package pixel
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SpatialHash is a broad-phase spatial index of rectangular bounds, such as the bounds of Sprites,
+// identified by integer ids. It quickly finds the objects near an area, which is useful for
+// collision detection and culling of many objects.
+//
+// The plane is divided into square cells of a fixed size and each object is stored in all of the
+// cells it's bounds overlap. Choose the cell size around the size of a typical object:
+//
+//   sh := pixel.NewSpatialHash(64)
+//   for id, e := range enemies {
+//   	sh.Insert(id, e.bounds)
+//   }
+//   for _, id := range sh.Query(win.Bounds()) {
+//   	enemies[id].Draw(win)
+//   }
+type SpatialHash struct {
+	cellSize float64
+	cells    map[spatialCell][]int
+	bounds   map[int]Rect
+}
+
+type spatialCell struct {
+	x, y int
+}
+
+// NewSpatialHash creates an empty SpatialHash with cells of the given size. The size must be
+// positive, otherwise this function panics.
+func NewSpatialHash(cellSize float64) *SpatialHash {
+	if cellSize <= 0 {
+		panic(fmt.Errorf("NewSpatialHash: cell size %v is not positive", cellSize))
+	}
+	return &SpatialHash{
+		cellSize: cellSize,
+		cells:    make(map[spatialCell][]int),
+		bounds:   make(map[int]Rect),
+	}
+}
+
+// Insert adds an object with the given id and bounds to the SpatialHash. If the id is already in
+// the SpatialHash, it's bounds are replaced, so Insert also moves objects.
+//
+// The bounds must be finite, otherwise this method panics.
+func (sh *SpatialHash) Insert(id int, bounds Rect) {
+	if !finiteRect(bounds) {
+		panic(fmt.Errorf("(%T).Insert: bounds %v are not finite", sh, bounds))
+	}
+	sh.Remove(id)
+	bounds = bounds.Norm()
+	sh.bounds[id] = bounds
+	sh.forEachCell(bounds, func(c spatialCell) {
+		sh.cells[c] = append(sh.cells[c], id)
+	})
+}
+
+// Remove removes the object with the given id from the SpatialHash. Removing an id which is not in
+// the SpatialHash does nothing.
+func (sh *SpatialHash) Remove(id int) {
+	bounds, ok := sh.bounds[id]
+	if !ok {
+		return
+	}
+	delete(sh.bounds, id)
+	sh.forEachCell(bounds, func(c spatialCell) {
+		ids := sh.cells[c]
+		for i := range ids {
+			if ids[i] == id {
+				ids[i] = ids[len(ids)-1]
+				ids = ids[:len(ids)-1]
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(sh.cells, c)
+		} else {
+			sh.cells[c] = ids
+		}
+	})
+}
+
+// Bounds returns the bounds of the object with the given id and whether it's in the SpatialHash.
+func (sh *SpatialHash) Bounds(id int) (bounds Rect, ok bool) {
+	bounds, ok = sh.bounds[id]
+	return bounds, ok
+}
+
+// Len returns the number of objects in the SpatialHash.
+func (sh *SpatialHash) Len() int {
+	return len(sh.bounds)
+}
+
+// Query returns the ids of all objects, whose bounds overlap or touch the area, in increasing
+// order. Each id is returned only once, even if the object spans multiple cells.
+//
+// If the area covers more cells than there are objects, such as an infinite area, the objects are
+// checked directly instead of going through the cells, so large areas are not slow.
+func (sh *SpatialHash) Query(area Rect) []int {
+	area = area.Norm()
+	overlaps := func(b Rect) bool {
+		return b.Min.X <= area.Max.X && area.Min.X <= b.Max.X && b.Min.Y <= area.Max.Y && area.Min.Y <= b.Max.Y
+	}
+
+	var ids []int
+	if !finiteRect(area) || sh.cellCount(area) > float64(sh.Len()) {
+		for id, b := range sh.bounds {
+			if overlaps(b) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Ints(ids)
+		return ids
+	}
+
+	seen := make(map[int]bool)
+	sh.forEachCell(area, func(c spatialCell) {
+		for _, id := range sh.cells[c] {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			if overlaps(sh.bounds[id]) {
+				ids = append(ids, id)
+			}
+		}
+	})
+	sort.Ints(ids)
+	return ids
+}
+
+// cellCount returns the number of cells overlapped by the normalized bounds. It's a float, so it
+// doesn't overflow for huge bounds.
+func (sh *SpatialHash) cellCount(bounds Rect) float64 {
+	w := math.Floor(bounds.Max.X/sh.cellSize) - math.Floor(bounds.Min.X/sh.cellSize) + 1
+	h := math.Floor(bounds.Max.Y/sh.cellSize) - math.Floor(bounds.Min.Y/sh.cellSize) + 1
+	return w * h
+}
+
+// finiteRect checks whether all coordinates of r are neither infinite nor NaN.
+func finiteRect(r Rect) bool {
+	for _, x := range [...]float64{r.Min.X, r.Min.Y, r.Max.X, r.Max.Y} {
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// forEachCell calls f for every cell overlapped by the normalized bounds.
+func (sh *SpatialHash) forEachCell(bounds Rect, f func(spatialCell)) {
+	var (
+		minX = int(math.Floor(bounds.Min.X / sh.cellSize))
+		minY = int(math.Floor(bounds.Min.Y / sh.cellSize))
+		maxX = int(math.Floor(bounds.Max.X / sh.cellSize))
+		maxY = int(math.Floor(bounds.Max.Y / sh.cellSize))
+	)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			f(spatialCell{x, y})
+		}
+	}
+}
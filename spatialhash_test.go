@@ -0,0 +1,85 @@
+package pixel_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestSpatialHash(t *testing.T) {
+	sh := pixel.NewSpatialHash(10)
+	sh.Insert(1, pixel.R(0, 0, 5, 5))
+	sh.Insert(2, pixel.R(8, 8, 25, 12)) // spans multiple cells
+	sh.Insert(3, pixel.R(-20, -20, -15, -15))
+	sh.Insert(4, pixel.R(6, 6, 7, 7))
+
+	tests := []struct {
+		name string
+		area pixel.Rect
+		want []int
+	}{
+		{name: "Single cell", area: pixel.R(0, 0, 9, 9), want: []int{1, 2, 4}},
+		{name: "Same cell, no overlap", area: pixel.R(0, 0, 1, 1), want: []int{1}},
+		{name: "Far cell of a large object", area: pixel.R(21, 9, 22, 10), want: []int{2}},
+		{name: "Negative cells", area: pixel.R(-16, -16, -14, -14), want: []int{3}},
+		{name: "Touching", area: pixel.R(5, -1, 5.5, 0), want: []int{1}},
+		{name: "Nothing", area: pixel.R(100, 100, 110, 110), want: nil},
+		{name: "Everything", area: pixel.R(-100, -100, 100, 100), want: []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sh.Query(tt.area); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SpatialHash.Query(%v) = %v, want %v", tt.area, got, tt.want)
+			}
+		})
+	}
+
+	// inserting an existing id moves the object
+	sh.Insert(2, pixel.R(50, 50, 51, 51))
+	sh.Remove(4)
+	sh.Remove(42)
+	if got, want := sh.Query(pixel.R(0, 0, 30, 30)), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SpatialHash.Query() after Insert and Remove = %v, want %v", got, want)
+	}
+	if got, want := sh.Query(pixel.R(45, 45, 55, 55)), []int{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SpatialHash.Query() of a moved object = %v, want %v", got, want)
+	}
+	if sh.Len() != 3 {
+		t.Errorf("SpatialHash.Len() = %d, want 3", sh.Len())
+	}
+	if _, ok := sh.Bounds(4); ok {
+		t.Errorf("SpatialHash.Bounds() of a removed object ok = true, want false")
+	}
+}
+
+func TestSpatialHash_LargeArea(t *testing.T) {
+	sh := pixel.NewSpatialHash(1)
+	sh.Insert(7, pixel.R(2, 2, 3, 3))
+
+	inf := math.Inf(1)
+	tests := []struct {
+		name string
+		area pixel.Rect
+		want []int
+	}{
+		{name: "Huge", area: pixel.R(-1e6, -1e6, 1e6, 1e6), want: []int{7}},
+		{name: "Infinite", area: pixel.R(-inf, -inf, inf, inf), want: []int{7}},
+		{name: "Half infinite", area: pixel.R(-inf, -inf, 0, 0), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sh.Query(tt.area); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SpatialHash.Query(%v) = %v, want %v", tt.area, got, tt.want)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SpatialHash.Insert() did not panic on infinite bounds")
+		}
+	}()
+	sh.Insert(8, pixel.R(0, 0, inf, 1))
+}
@@ -2,7 +2,8 @@ package pixel
 
 import "image/color"
 
-// Sprite is a drawable frame of a Picture. It's anchored by the center of it's Picture's frame.
+// Sprite is a drawable frame of a Picture. It's anchored by the center of it's Picture's frame,
+// unless set otherwise by SetAnchor.
 //
 // Frame specifies a rectangular portion of the Picture that will be drawn. For example, this
 // creates a Sprite that draws the whole Picture:
@@ -20,6 +21,15 @@ type Sprite struct {
 
 	matrix Matrix
 	mask   RGBA
+	flipX  bool
+	flipY  bool
+
+	uvOffset Vec
+	uvScale  Vec
+	anchor   Vec
+
+	quad    bool
+	corners [4]Vec
 }
 
 // NewSprite creates a Sprite from the supplied frame of a Picture.
@@ -31,6 +41,8 @@ func NewSprite(pic Picture, frame Rect) *Sprite {
 	}
 	s.matrix = IM
 	s.mask = Alpha(1)
+	s.uvScale = V(1, 1)
+	s.anchor = V(0.5, 0.5)
 	s.Set(pic, frame)
 	return s
 }
@@ -54,19 +66,174 @@ func (s *Sprite) Frame() Rect {
 	return s.frame
 }
 
+// SetFlip sets whether the Picture of the Sprite is mirrored horizontally (flipX) or vertically
+// (flipY). Only the Picture is mirrored, the Sprite still covers the same area. Flipping both ways
+// rotates the Picture by 180 degrees.
+//
+// The flip is kept when changing the frame with Set.
+func (s *Sprite) SetFlip(flipX, flipY bool) {
+	if flipX != s.flipX || flipY != s.flipY {
+		s.flipX, s.flipY = flipX, flipY
+		s.calcData()
+	}
+}
+
+// Flip returns whether the Picture of the Sprite is mirrored horizontally and vertically.
+func (s *Sprite) Flip() (flipX, flipY bool) {
+	return s.flipX, s.flipY
+}
+
+// SetUVOffset sets a vector, which is added to the Picture coordinates of the Sprite. Only the
+// Picture moves, the Sprite still covers the same area. Changing the offset over time scrolls the
+// Picture, which is useful for effects like flowing water.
+//
+// The offset is applied after the flip and the UV scale. Picture coordinates outside of the
+// Picture's bounds are not wrapped, both ImageTarget and pixelgl draw them fully transparent. The
+// default offset is the zero vector.
+func (s *Sprite) SetUVOffset(offset Vec) {
+	if offset != s.uvOffset {
+		s.uvOffset = offset
+		s.calcData()
+	}
+}
+
+// UVOffset returns the current offset of the Sprite's Picture coordinates.
+func (s *Sprite) UVOffset() Vec {
+	return s.uvOffset
+}
+
+// SetUVScale sets how much the Picture coordinates of the Sprite are scaled around the center of the
+// frame. For example, scale V(2, 2) makes the Sprite show twice as large portion of the Picture,
+// so the Picture appears half the size. The default scale is V(1, 1).
+func (s *Sprite) SetUVScale(scale Vec) {
+	if scale != s.uvScale {
+		s.uvScale = scale
+		s.calcData()
+	}
+}
+
+// UVScale returns the current scale of the Sprite's Picture coordinates.
+func (s *Sprite) UVScale() Vec {
+	return s.uvScale
+}
+
+// SetAnchor sets the point of the Sprite's frame, which is placed at the origin before the Sprite
+// is transformed by a Matrix, so the Sprite rotates and scales around it. The anchor is relative to
+// the frame, just like in Rect.ResizedRelative, V(0, 0) is the bottom left corner, V(1, 1) is the
+// top right corner and V(0.5, 0.5) is the center, which is the default.
+//
+//   sprite.SetAnchor(pixel.V(0.5, 0)) // the feet of a character
+//   sprite.Draw(win, pixel.IM.Rotated(pixel.ZV, angle).Moved(pos))
+//
+// The anchor is kept when changing the frame with Set.
+func (s *Sprite) SetAnchor(anchor Vec) {
+	if anchor != s.anchor {
+		s.anchor = anchor
+		s.calcData()
+	}
+}
+
+// Anchor returns the point of the Sprite's frame placed at the origin, relative to the frame.
+func (s *Sprite) Anchor() Vec {
+	return s.anchor
+}
+
+// anchorOffset returns the position of the anchor relative to the center of the frame.
+func (s *Sprite) anchorOffset() Vec {
+	return s.anchor.Sub(V(0.5, 0.5)).ScaledXY(s.frame.Size())
+}
+
+// Bounds returns the rectangle the Sprite covers before it's transformed by a Matrix. The size of
+// the rectangle is the size of the Sprite's frame and since Sprite is anchored by it's center by
+// default, the rectangle is centered around the origin, unless SetAnchor is used.
+//
+// Use Matrix.Project on it's Vertices to get the bounds after the transformation.
+func (s *Sprite) Bounds() Rect {
+	return s.frame.Moved(s.frame.Center().Add(s.anchorOffset()).Scaled(-1))
+}
+
+// Contains checks whether a point, given in the coordinates of the Sprite before it's transformed
+// by a Matrix, lies inside the Sprite's Bounds.
+func (s *Sprite) Contains(local Vec) bool {
+	return s.Bounds().Contains(local)
+}
+
+// ContainsWorld checks whether a point lies inside the Sprite drawn with the given Matrix. The point
+// is unprojected by the Matrix and checked with Contains, so the Matrix must be invertible.
+//
+// This is useful for checking whether a Sprite was clicked:
+//
+//   if win.JustPressed(pixelgl.MouseButtonLeft) && sprite.ContainsWorld(win.MousePosition(), mat) {
+//   	// the sprite was clicked
+//   }
+func (s *Sprite) ContainsWorld(p Vec, matrix Matrix) bool {
+	return s.Contains(matrix.Unproject(p))
+}
+
+// ContainsPixel checks whether a point, given in the coordinates of the Sprite before it's
+// transformed by a Matrix, lies on a pixel of the Sprite, whose alpha is at least the threshold.
+// Unlike Contains, this ignores the transparent parts of irregular Sprites.
+//
+// The pixel is read from the provided PictureData, which should be the Sprite's Picture, or it's
+// CPU copy made by PictureDataFromPicture. The flip, the UV scale and the UV offset are taken into
+// account. Points outside of the Sprite's Bounds and points mapped outside of the PictureData
+// return false.
+func (s *Sprite) ContainsPixel(local Vec, pic *PictureData, threshold float64) bool {
+	if !s.Contains(local) {
+		return false
+	}
+
+	flip := V(1, 1)
+	if s.flipX {
+		flip.X = -1
+	}
+	if s.flipY {
+		flip.Y = -1
+	}
+	local = local.Add(s.anchorOffset())
+	at := s.frame.Center().Add(local.ScaledXY(flip).ScaledXY(s.uvScale)).Add(s.uvOffset)
+
+	// the Max edge belongs to the next pixel, which is not in the PictureData
+	r := pic.Bounds()
+	if at.X < r.Min.X || at.X >= r.Max.X || at.Y < r.Min.Y || at.Y >= r.Max.Y {
+		return false
+	}
+	return pic.Color(at).A >= threshold
+}
+
 // Draw draws the Sprite onto the provided Target. The Sprite will be transformed by the given Matrix.
 //
+// The Matrix is applied before the Target's own Matrix, set by it's SetMatrix, so the two compose.
+// Sprite remembers the last Matrix and only transforms it's vertices again when the Matrix changes,
+// so drawing repeatedly with the same Matrix is cheap.
+//
 // This method is equivalent to calling DrawColorMask with nil color mask.
 func (s *Sprite) Draw(t Target, matrix Matrix) {
 	s.DrawColorMask(t, matrix, nil)
 }
 
+// DrawRotated draws the Sprite onto the provided Target with it's anchor at the given position,
+// rotated by the angle and scaled by the scale around the anchor. This is a shorthand for the most
+// common Matrix:
+//
+//   sprite.Draw(t, pixel.IM.Scaled(pixel.ZV, scale).Rotated(pixel.ZV, angle).Moved(pos))
+//
+// Since the anchor of the Sprite is at it's origin, rotating and scaling around the origin is
+// rotating and scaling around the anchor, which is the center by default.
+func (s *Sprite) DrawRotated(t Target, pos Vec, angle, scale float64) {
+	s.Draw(t, IM.Scaled(ZV, scale).Rotated(ZV, angle).Moved(pos))
+}
+
 // DrawColorMask draws the Sprite onto the provided Target. The Sprite will be transformed by the
 // given Matrix and all of it's color will be multiplied by the given mask.
 //
 // If the mask is nil, a fully opaque white mask will be used, which causes no effect.
 func (s *Sprite) DrawColorMask(t Target, matrix Matrix, mask color.Color) {
 	dirty := false
+	if s.quad {
+		s.quad = false
+		dirty = true
+	}
 	if matrix != s.matrix {
 		s.matrix = matrix
 		dirty = true
@@ -87,6 +254,30 @@ func (s *Sprite) DrawColorMask(t Target, matrix Matrix, mask color.Color) {
 	s.d.Draw(t)
 }
 
+// DrawQuad draws the Sprite onto the provided Target stretched to an arbitrary quadrilateral, which
+// is useful for pseudo-3D and skew effects. The corners are in the same order as Rect.Vertices
+// returns them, so this draws the Sprite the same as Draw with the identity Matrix:
+//
+//   sprite.DrawQuad(t, sprite.Bounds().Vertices())
+//
+// The Sprite is drawn as two triangles split along the diagonal from the first to the third corner,
+// so the Picture is warped affinely within each triangle. This is not a perspective-correct
+// projection, the Picture bends along the diagonal for quadrilaterals far from parallelograms.
+//
+// If the corners enclose no area, nothing is drawn.
+func (s *Sprite) DrawQuad(t Target, corners [4]Vec) {
+	if Polygon(corners[:]).Area() == 0 {
+		return
+	}
+	if !s.quad || corners != s.corners || s.mask != Alpha(1) {
+		s.quad = true
+		s.corners = corners
+		s.mask = Alpha(1)
+		s.calcData()
+	}
+	s.d.Draw(t)
+}
+
 func (s *Sprite) calcData() {
 	var (
 		center     = s.frame.Center()
@@ -101,11 +292,26 @@ func (s *Sprite) calcData() {
 	(*s.tri)[4].Position = Vec{}.Add(horizontal).Add(vertical)
 	(*s.tri)[5].Position = Vec{}.Sub(horizontal).Add(vertical)
 
+	flip := V(1, 1)
+	if s.flipX {
+		flip.X = -1
+	}
+	if s.flipY {
+		flip.Y = -1
+	}
+
+	offset := s.anchorOffset()
 	for i := range *s.tri {
 		(*s.tri)[i].Color = s.mask
-		(*s.tri)[i].Picture = center.Add((*s.tri)[i].Position)
+		(*s.tri)[i].Picture = center.Add((*s.tri)[i].Position.ScaledXY(flip).ScaledXY(s.uvScale)).Add(s.uvOffset)
 		(*s.tri)[i].Intensity = 1
-		(*s.tri)[i].Position = s.matrix.Project((*s.tri)[i].Position)
+		(*s.tri)[i].Position = s.matrix.Project((*s.tri)[i].Position.Sub(offset))
+	}
+
+	if s.quad {
+		for i, corner := range [...]int{0, 3, 2, 0, 2, 1} {
+			(*s.tri)[i].Position = s.corners[corner]
+		}
 	}
 
 	s.d.Dirty()
@@ -0,0 +1,301 @@
+package pixel_test
+
+import (
+	"image/color"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestSprite_Bounds(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(0, 0, 64, 32))
+	tests := []struct {
+		name  string
+		frame pixel.Rect
+		want  pixel.Rect
+	}{
+		{name: "Whole picture", frame: pic.Bounds(), want: pixel.R(-32, -16, 32, 16)},
+		{name: "Frame", frame: pixel.R(16, 8, 26, 28), want: pixel.R(-5, -10, 5, 10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sprite := pixel.NewSprite(pic, tt.frame)
+			if got := sprite.Bounds(); got != tt.want {
+				t.Errorf("Sprite.Bounds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSprite_SetFlip(t *testing.T) {
+	var (
+		red    = pixel.RGB(1, 0, 0)
+		green  = pixel.RGB(0, 1, 0)
+		blue   = pixel.RGB(0, 0, 1)
+		yellow = pixel.RGB(1, 1, 0)
+	)
+
+	// the sprite's frame is the top right 2x2 corner of the picture
+	pic := pixel.MakePictureData(pixel.R(0, 0, 3, 3))
+	pic.Pix[pic.Index(pixel.V(1, 1))] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(2, 1))] = color.RGBA{G: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(1, 2))] = color.RGBA{B: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(2, 2))] = color.RGBA{R: 0xff, G: 0xff, A: 0xff}
+
+	tests := []struct {
+		name         string
+		flipX, flipY bool
+		want         [2][2]pixel.RGBA // want[y][x]
+	}{
+		{name: "No flip", want: [2][2]pixel.RGBA{{red, green}, {blue, yellow}}},
+		{name: "Flip X", flipX: true, want: [2][2]pixel.RGBA{{green, red}, {yellow, blue}}},
+		{name: "Flip Y", flipY: true, want: [2][2]pixel.RGBA{{blue, yellow}, {red, green}}},
+		{name: "Flip both", flipX: true, flipY: true, want: [2][2]pixel.RGBA{{yellow, blue}, {green, red}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sprite := pixel.NewSprite(pic, pic.Bounds())
+			sprite.SetFlip(tt.flipX, tt.flipY)
+			sprite.Set(pic, pixel.R(1, 1, 3, 3))
+
+			it := pixel.NewImageTarget(pixel.R(0, 0, 2, 2))
+			sprite.Draw(it, pixel.IM.Moved(pixel.V(1, 1)))
+
+			for y := range tt.want {
+				for x := range tt.want[y] {
+					at := pixel.V(float64(x)+0.5, float64(y)+0.5)
+					if got := it.Color(at); got != tt.want[y][x] {
+						t.Errorf("pixel (%d, %d) = %v, want %v", x, y, got, tt.want[y][x])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSprite_SetUV(t *testing.T) {
+	var (
+		red   = pixel.RGB(1, 0, 0)
+		green = pixel.RGB(0, 1, 0)
+		blue  = pixel.RGB(0, 0, 1)
+	)
+
+	// a 4x1 stripe of colors, the sprite's frame is the second and the third pixel
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 1))
+	pic.Pix[pic.Index(pixel.V(0, 0))] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(1, 0))] = color.RGBA{G: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(2, 0))] = color.RGBA{B: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(3, 0))] = color.RGBA{R: 0xff, A: 0xff}
+
+	tests := []struct {
+		name   string
+		offset pixel.Vec
+		scale  pixel.Vec
+		want   [2]pixel.RGBA
+	}{
+		{name: "Default", scale: pixel.V(1, 1), want: [2]pixel.RGBA{green, blue}},
+		{name: "Offset", offset: pixel.V(-1, 0), scale: pixel.V(1, 1), want: [2]pixel.RGBA{red, green}},
+		{name: "Offset outside", offset: pixel.V(2, 0), scale: pixel.V(1, 1), want: [2]pixel.RGBA{red, {}}},
+		{name: "Scale", scale: pixel.V(3, 1), want: [2]pixel.RGBA{red, red}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sprite := pixel.NewSprite(pic, pixel.R(1, 0, 3, 1))
+			sprite.SetUVOffset(tt.offset)
+			sprite.SetUVScale(tt.scale)
+
+			it := pixel.NewImageTarget(pixel.R(0, 0, 2, 1))
+			sprite.Draw(it, pixel.IM.Moved(pixel.V(1, 0.5)))
+
+			for x := range tt.want {
+				at := pixel.V(float64(x)+0.5, 0.5)
+				if got := it.Color(at); got != tt.want[x] {
+					t.Errorf("pixel %d = %v, want %v", x, got, tt.want[x])
+				}
+			}
+		})
+	}
+}
+
+func TestSprite_DrawRotated(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 2))
+	for i := range pic.Pix {
+		pic.Pix[i] = color.RGBA{255, 255, 255, 255}
+	}
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+
+	// rotated by 90 degrees and scaled twice, the sprite covers 4x8 pixels around the position
+	it := pixel.NewImageTarget(pixel.R(0, 0, 16, 16))
+	sprite.DrawRotated(it, pixel.V(8, 8), math.Pi/2, 2)
+
+	tests := []struct {
+		at   pixel.Vec
+		want bool
+	}{
+		{at: pixel.V(8, 8), want: true},
+		{at: pixel.V(6.5, 4.5), want: true},
+		{at: pixel.V(9.5, 11.5), want: true},
+		{at: pixel.V(11.5, 8), want: false},
+		{at: pixel.V(8, 12.5), want: false},
+	}
+	for _, tt := range tests {
+		if got := it.Color(tt.at).A > 0.5; got != tt.want {
+			t.Errorf("pixel at %v drawn = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestSprite_DrawQuad(t *testing.T) {
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+	pic := pixel.MakePictureData(pixel.R(0, 0, 2, 1))
+	pic.Pix[pic.Index(pixel.V(0, 0))] = color.RGBA{R: 0xff, A: 0xff}
+	pic.Pix[pic.Index(pixel.V(1, 0))] = color.RGBA{B: 0xff, A: 0xff}
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+
+	// a parallelogram leaning to the right
+	it := pixel.NewImageTarget(pixel.R(0, 0, 6, 2))
+	sprite.DrawQuad(it, [4]pixel.Vec{pixel.V(0, 0), pixel.V(2, 2), pixel.V(6, 2), pixel.V(4, 0)})
+	tests := []struct {
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{at: pixel.V(1.5, 0.5), want: red},
+		{at: pixel.V(3.5, 0.5), want: blue},
+		{at: pixel.V(2.5, 1.5), want: red},
+		{at: pixel.V(4.5, 1.5), want: blue},
+		{at: pixel.V(0.5, 1.5), want: pixel.RGBA{}},
+		{at: pixel.V(5.5, 0.5), want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		if got := it.Color(tt.at); got != tt.want {
+			t.Errorf("pixel at %v = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	// degenerate corners draw nothing
+	empty := pixel.NewImageTarget(pixel.R(0, 0, 6, 2))
+	sprite.DrawQuad(empty, [4]pixel.Vec{pixel.V(0, 0), pixel.V(1, 1), pixel.V(2, 2), pixel.V(3, 3)})
+	for _, c := range empty.Image().Pix {
+		if c != 0 {
+			t.Fatalf("DrawQuad() with degenerate corners drew something")
+		}
+	}
+
+	// the corners of the moved Bounds draw the same as the moved Matrix, which Draw remembers
+	mat := pixel.IM.Moved(pixel.V(1, 0.5))
+	a, b := pixel.NewImageTarget(pixel.R(0, 0, 2, 1)), pixel.NewImageTarget(pixel.R(0, 0, 2, 1))
+	sprite.Draw(a, mat)
+	sprite.DrawQuad(b, sprite.Bounds().Moved(mat.Project(pixel.ZV)).Vertices())
+	if !reflect.DeepEqual(a.Image().Pix, b.Image().Pix) {
+		t.Errorf("DrawQuad() with the Bounds' corners differs from Draw()")
+	}
+	sprite.DrawQuad(empty, [4]pixel.Vec{pixel.V(0, 0), pixel.V(2, 2), pixel.V(6, 2), pixel.V(4, 0)})
+	a.Clear(pixel.Alpha(0))
+	sprite.Draw(a, mat)
+	if !reflect.DeepEqual(a.Image().Pix, b.Image().Pix) {
+		t.Errorf("Draw() after DrawQuad() with the same Matrix as before differs")
+	}
+}
+
+func TestSprite_SetAnchor(t *testing.T) {
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 2))
+	for i := range pic.Pix {
+		pic.Pix[i] = color.RGBA{R: 0xff, A: 0xff}
+	}
+	pic.Pix[pic.Index(pixel.V(0, 0))] = color.RGBA{B: 0xff, A: 0xff}
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+
+	if sprite.Anchor() != pixel.V(0.5, 0.5) {
+		t.Errorf("Sprite.Anchor() = %v, want the center", sprite.Anchor())
+	}
+	sprite.SetAnchor(pixel.V(0, 0))
+	if got, want := sprite.Bounds(), pixel.R(0, 0, 4, 2); got != want {
+		t.Errorf("Sprite.Bounds() = %v, want %v", got, want)
+	}
+
+	// rotated by 90 degrees around the bottom left corner, the sprite points up from it
+	it := pixel.NewImageTarget(pixel.R(0, 0, 8, 8))
+	sprite.DrawRotated(it, pixel.V(4, 2), math.Pi/2, 1)
+	tests := []struct {
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{at: pixel.V(3.5, 2.5), want: blue},
+		{at: pixel.V(2.5, 5.5), want: red},
+		{at: pixel.V(4.5, 2.5), want: pixel.RGBA{}},
+		{at: pixel.V(3.5, 6.5), want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		if got := it.Color(tt.at); got != tt.want {
+			t.Errorf("pixel at %v = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	if !sprite.ContainsPixel(pixel.V(0.5, 0.5), pic, 0.5) || sprite.ContainsPixel(pixel.V(-0.5, 0.5), pic, 0.5) {
+		t.Errorf("Sprite.ContainsPixel() does not respect the anchor")
+	}
+}
+
+func TestSprite_ContainsWorld(t *testing.T) {
+	pic := pixel.MakePictureData(pixel.R(0, 0, 20, 10))
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+	matrix := pixel.IM.Rotated(pixel.ZV, math.Pi/2).Moved(pixel.V(100, 100))
+
+	tests := []struct {
+		name string
+		p    pixel.Vec
+		want bool
+	}{
+		{name: "Center", p: pixel.V(100, 100), want: true},
+		{name: "Inside rotated", p: pixel.V(104, 109), want: true},
+		{name: "Outside rotated", p: pixel.V(109, 104), want: false},
+		{name: "Far away", p: pixel.V(0, 0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sprite.ContainsWorld(tt.p, matrix); got != tt.want {
+				t.Errorf("Sprite.ContainsWorld(%v) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+
+	if !sprite.Contains(pixel.V(-9, 4)) || sprite.Contains(pixel.V(4, -9)) {
+		t.Errorf("Sprite.Contains() doesn't match the Sprite's Bounds %v", sprite.Bounds())
+	}
+}
+
+func TestSprite_ContainsPixel(t *testing.T) {
+	// the left half of the picture is opaque, the right half is transparent
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			pic.Pix[pic.Index(pixel.V(float64(x), float64(y)))] = color.RGBA{255, 255, 255, 255}
+		}
+	}
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+
+	tests := []struct {
+		name  string
+		flipX bool
+		local pixel.Vec
+		want  bool
+	}{
+		{name: "Opaque", local: pixel.V(-1, 0), want: true},
+		{name: "Transparent", local: pixel.V(1, 0), want: false},
+		{name: "Out of bounds", local: pixel.V(5, 0), want: false},
+		{name: "Max edge", local: pixel.V(2, 1), want: false},
+		{name: "Flipped opaque", flipX: true, local: pixel.V(1, 0), want: true},
+		{name: "Flipped transparent", flipX: true, local: pixel.V(-1, 0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sprite.SetFlip(tt.flipX, false)
+			if got := sprite.ContainsPixel(tt.local, pic, 0.5); got != tt.want {
+				t.Errorf("Sprite.ContainsPixel(%v) = %v, want %v", tt.local, got, tt.want)
+			}
+		})
+	}
+}
@@ -24,12 +24,13 @@ type Glyph struct {
 
 // Atlas is a set of pre-drawn glyphs of a fixed set of runes. This allows for efficient text drawing.
 type Atlas struct {
-	face       font.Face
-	pic        pixel.Picture
-	mapping    map[rune]Glyph
-	ascent     float64
-	descent    float64
-	lineHeight float64
+	face        font.Face
+	pic         pixel.Picture
+	mapping     map[rune]Glyph
+	ascent      float64
+	descent     float64
+	lineHeight  float64
+	replacement rune
 }
 
 // NewAtlas creates a new Atlas containing glyphs of the union of the given sets of runes (plus
@@ -89,21 +90,59 @@ func NewAtlas(face font.Face, runeSets ...[]rune) *Atlas {
 	}
 
 	return &Atlas{
-		face:       face,
-		pic:        pixel.PictureDataFromImage(atlasImg),
-		mapping:    mapping,
-		ascent:     i2f(face.Metrics().Ascent),
-		descent:    i2f(face.Metrics().Descent),
-		lineHeight: i2f(face.Metrics().Height),
+		face:        face,
+		pic:         pixel.PictureDataFromImage(atlasImg),
+		mapping:     mapping,
+		ascent:      i2f(face.Metrics().Ascent),
+		descent:     i2f(face.Metrics().Descent),
+		lineHeight:  i2f(face.Metrics().Height),
+		replacement: unicode.ReplacementChar,
 	}
 }
 
+// NewBitmapAtlas creates a new Atlas from a Picture with already drawn glyphs, such as a bitmap
+// font loaded from an image. Each Glyph in the mapping specifies the Frame of the rune's glyph
+// inside the Picture, the Dot, which is the position inside the Picture that gets placed at the
+// dot when drawing, and the Advance, which is how much the dot moves after drawing the glyph.
+//
+// Ascent, descent and line height are the metrics of the font, see the corresponding methods.
+// Bitmap Atlases have no kerning. The mapping is copied, so it's safe to change it afterwards.
+//
+// Runes missing in the mapping are drawn as unicode.ReplacementChar, use SetReplacement to change
+// it to a rune, which the bitmap font contains.
+func NewBitmapAtlas(pic pixel.Picture, mapping map[rune]Glyph, ascent, descent, lineHeight float64) *Atlas {
+	a := &Atlas{
+		pic:         pic,
+		mapping:     make(map[rune]Glyph, len(mapping)),
+		ascent:      ascent,
+		descent:     descent,
+		lineHeight:  lineHeight,
+		replacement: unicode.ReplacementChar,
+	}
+	for r, g := range mapping {
+		a.mapping[r] = g
+	}
+	return a
+}
+
 // Picture returns the underlying Picture containing an arrangement of all the glyphs contained
 // within the Atlas.
 func (a *Atlas) Picture() pixel.Picture {
 	return a.pic
 }
 
+// SetReplacement sets the rune, which is drawn instead of runes not contained within the Atlas. The
+// default is unicode.ReplacementChar. If the Atlas doesn't contain the replacement rune either,
+// missing runes are not drawn at all.
+func (a *Atlas) SetReplacement(r rune) {
+	a.replacement = r
+}
+
+// Replacement returns the rune, which is drawn instead of runes not contained within the Atlas.
+func (a *Atlas) Replacement() rune {
+	return a.replacement
+}
+
 // Contains reports wheter r in contained within the Atlas.
 func (a *Atlas) Contains(r rune) bool {
 	_, ok := a.mapping[r]
@@ -117,7 +156,12 @@ func (a *Atlas) Glyph(r rune) Glyph {
 
 // Kern returns the kerning distance between runes r0 and r1. Positive distance means that the
 // glyphs should be further apart.
+//
+// Atlases created by NewBitmapAtlas have no kerning, so the distance is always zero.
 func (a *Atlas) Kern(r0, r1 rune) float64 {
+	if a.face == nil {
+		return 0
+	}
 	return i2f(a.face.Kern(r0, r1))
 }
 
@@ -142,13 +186,13 @@ func (a *Atlas) LineHeight() float64 {
 // Atlas's Picture. NewDot is the new position of the dot.
 func (a *Atlas) DrawRune(prevR, r rune, dot pixel.Vec) (rect, frame, bounds pixel.Rect, newDot pixel.Vec) {
 	if !a.Contains(r) {
-		r = unicode.ReplacementChar
+		r = a.replacement
 	}
-	if !a.Contains(unicode.ReplacementChar) {
+	if !a.Contains(a.replacement) {
 		return pixel.Rect{}, pixel.Rect{}, pixel.Rect{}, dot
 	}
 	if !a.Contains(prevR) {
-		prevR = unicode.ReplacementChar
+		prevR = a.replacement
 	}
 
 	if prevR >= 0 {
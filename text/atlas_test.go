@@ -3,6 +3,7 @@ package text_test
 import (
 	"testing"
 
+	"github.com/faiface/pixel"
 	"github.com/faiface/pixel/text"
 )
 
@@ -22,3 +23,31 @@ func TestAtlas7x13(t *testing.T) {
 		}
 	}
 }
+
+func TestNewBitmapAtlas(t *testing.T) {
+	// two 2x2 glyphs side by side, the dot is at the bottom left corner of each glyph
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 2))
+	atlas := text.NewBitmapAtlas(pic, map[rune]text.Glyph{
+		'a': {Dot: pixel.V(0, 0), Frame: pixel.R(0, 0, 2, 2), Advance: 2},
+		'b': {Dot: pixel.V(2, 0), Frame: pixel.R(2, 0, 4, 2), Advance: 2},
+	}, 2, 0, 3)
+
+	if got := atlas.Kern('a', 'b'); got != 0 {
+		t.Errorf("Atlas.Kern() = %v, want 0", got)
+	}
+
+	// missing runes are not drawn until the replacement is contained within the Atlas
+	if rect, _, _, dot := atlas.DrawRune(-1, 'c', pixel.ZV); rect != (pixel.Rect{}) || dot != pixel.ZV {
+		t.Errorf("Atlas.DrawRune() of a missing rune = %v, %v, want nothing drawn", rect, dot)
+	}
+	atlas.SetReplacement('b')
+	if _, frame, _, _ := atlas.DrawRune(-1, 'c', pixel.ZV); frame != pixel.R(2, 0, 4, 2) {
+		t.Errorf("Atlas.DrawRune() of a missing rune frame = %v, want %v", frame, pixel.R(2, 0, 4, 2))
+	}
+
+	txt := text.New(pixel.ZV, atlas)
+	txt.WriteString("ac\na")
+	if got, want := txt.Bounds(), pixel.R(0, -3, 4, 2); got != want {
+		t.Errorf("Text.Bounds() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,126 @@
+package pixel
+
+import "math"
+
+// TiledSprite is a frame of a Picture repeated to fill a rectangle, such as a tiled background. All
+// repeats are drawn at once as a single Triangles.
+//
+// The repeats are made of separate quads, one for each repeat, with the ones at the edges cut to
+// the bounds. So, unlike repeating the Picture coordinates, this doesn't rely on the Target
+// wrapping the Picture and works with any Target and any size of the frame, including sizes, which
+// are not powers of two.
+//
+// The repeats are aligned to the bottom left corner of the bounds and shifted by the scroll, which
+// is useful for parallax:
+//
+//   bg := pixel.NewTiledSprite(pic, pic.Bounds())
+//   bg.SetBounds(win.Bounds())
+//   bg.SetScroll(camPos.Scaled(-0.5)) // moves at half the speed of the camera
+//   bg.Draw(win)
+type TiledSprite struct {
+	frame  Rect
+	bounds Rect
+	scroll Vec
+
+	tri *TrianglesData
+	d   Drawer
+}
+
+var _ Drawable = (*TiledSprite)(nil)
+
+// NewTiledSprite creates a TiledSprite repeating the supplied frame of a Picture. The initial
+// bounds of the TiledSprite are the frame, so it covers a single repeat.
+func NewTiledSprite(pic Picture, frame Rect) *TiledSprite {
+	tri := &TrianglesData{}
+	ts := &TiledSprite{
+		frame: frame.Norm(),
+		tri:   tri,
+		d:     Drawer{Triangles: tri, Picture: pic},
+	}
+	ts.SetBounds(frame)
+	return ts
+}
+
+// SetBounds sets the rectangle the TiledSprite fills with the repeats of it's frame.
+func (ts *TiledSprite) SetBounds(r Rect) {
+	r = r.Norm()
+	if r != ts.bounds {
+		ts.bounds = r
+		ts.calcData()
+	}
+}
+
+// Bounds returns the rectangle the TiledSprite fills.
+func (ts *TiledSprite) Bounds() Rect {
+	return ts.bounds
+}
+
+// SetScroll sets the offset the repeats are shifted by. Offsets of whole multiples of the frame size
+// look the same.
+func (ts *TiledSprite) SetScroll(offset Vec) {
+	if offset != ts.scroll {
+		ts.scroll = offset
+		ts.calcData()
+	}
+}
+
+// Scroll returns the offset the repeats are shifted by.
+func (ts *TiledSprite) Scroll() Vec {
+	return ts.scroll
+}
+
+// Draw draws the TiledSprite onto the provided Target.
+func (ts *TiledSprite) Draw(t Target) {
+	ts.d.Draw(t)
+}
+
+func (ts *TiledSprite) calcData() {
+	xs := tiledSpriteSplit(ts.bounds.Min.X, ts.bounds.Max.X, ts.frame.W(), ts.scroll.X)
+	ys := tiledSpriteSplit(ts.bounds.Min.Y, ts.bounds.Max.Y, ts.frame.H(), ts.scroll.Y)
+
+	ts.tri.SetLen(6 * len(xs) * len(ys))
+	i := 0
+	for _, y := range ys {
+		for _, x := range xs {
+			for _, corner := range [...][2]int{{0, 0}, {1, 0}, {1, 1}, {0, 0}, {1, 1}, {0, 1}} {
+				(*ts.tri)[i].Position = V(x.pos[corner[0]], y.pos[corner[1]])
+				(*ts.tri)[i].Picture = ts.frame.Min.Add(V(x.pic[corner[0]], y.pic[corner[1]]))
+				(*ts.tri)[i].Intensity = 1
+				i++
+			}
+		}
+	}
+
+	ts.d.Dirty()
+}
+
+// tiledSpriteSpan is a single repeat along one axis, cut to the bounds. The Picture coordinates are
+// relative to the frame.
+type tiledSpriteSpan struct {
+	pos, pic [2]float64
+}
+
+// tiledSpriteSplit splits the range from min to max into repeats of the given size, aligned to min
+// shifted by the scroll.
+func tiledSpriteSplit(min, max, size, scroll float64) []tiledSpriteSpan {
+	if size <= 0 || max <= min {
+		return nil
+	}
+	start := min + math.Mod(scroll, size)
+	if start > min {
+		start -= size
+	}
+
+	var spans []tiledSpriteSpan
+	for x := start; x < max; x += size {
+		from, to := math.Max(x, min), math.Min(x+size, max)
+		if to <= from {
+			continue
+		}
+		spans = append(spans, tiledSpriteSpan{
+			pos: [2]float64{from, to},
+			pic: [2]float64{from - x, to - x},
+		})
+	}
+	return spans
+}
@@ -0,0 +1,62 @@
+package pixel_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestTiledSprite(t *testing.T) {
+	red, blue := pixel.RGB(1, 0, 0), pixel.RGB(0, 0, 1)
+
+	// the left half of the picture is red, the right half is blue
+	pic := pixel.MakePictureData(pixel.R(0, 0, 4, 1))
+	for x := 0; x < 4; x++ {
+		c := color.RGBA{R: 0xff, A: 0xff}
+		if x >= 2 {
+			c = color.RGBA{B: 0xff, A: 0xff}
+		}
+		pic.Pix[pic.Index(pixel.V(float64(x), 0))] = c
+	}
+
+	tests := []struct {
+		name   string
+		scroll pixel.Vec
+		want   []pixel.RGBA
+	}{
+		{name: "No scroll", want: []pixel.RGBA{red, red, blue, blue, red, red, blue, blue, red, red}},
+		{name: "Scroll", scroll: pixel.V(1, 0), want: []pixel.RGBA{blue, red, red, blue, blue, red, red, blue, blue, red}},
+		{name: "Negative scroll", scroll: pixel.V(-5, 0), want: []pixel.RGBA{red, blue, blue, red, red, blue, blue, red, red, blue}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := pixel.NewTiledSprite(pic, pic.Bounds())
+			ts.SetBounds(pixel.R(0, 0, 10, 1))
+			ts.SetScroll(tt.scroll)
+
+			it := pixel.NewImageTarget(pixel.R(0, 0, 10, 1))
+			ts.Draw(it)
+			for x, want := range tt.want {
+				if got := it.Color(pixel.V(float64(x)+0.5, 0.5)); got != want {
+					t.Errorf("pixel %d = %v, want %v", x, got, want)
+				}
+			}
+		})
+	}
+
+	ts := pixel.NewTiledSprite(pic, pic.Bounds())
+	if ts.Bounds() != pic.Bounds() {
+		t.Errorf("TiledSprite.Bounds() = %v, want %v", ts.Bounds(), pic.Bounds())
+	}
+
+	// empty bounds draw nothing
+	ts.SetBounds(pixel.Rect{})
+	it := pixel.NewImageTarget(pic.Bounds())
+	ts.Draw(it)
+	for x := 0; x < 4; x++ {
+		if got := it.Color(pixel.V(float64(x)+0.5, 0.5)); got != (pixel.RGBA{}) {
+			t.Errorf("pixel %d with empty bounds = %v, want %v", x, got, pixel.RGBA{})
+		}
+	}
+}
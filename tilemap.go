@@ -0,0 +1,145 @@
+package pixel
+
+import (
+	"fmt"
+	"math"
+)
+
+// TileMap is a rectangular grid of tiles, where each tile is a frame of a single Picture, a tile
+// atlas. All visible tiles are drawn at once through a Batch, which makes drawing large grids
+// efficient.
+//
+// Tiles in the atlas are indexed from 0, left to right and top to bottom. A tile at the position
+// (x, y) of the grid covers the rectangle from (x*w, y*h) to ((x+1)*w, (y+1)*h), where (w, h) is
+// the size of a tile. That means that the grid goes up with growing y, just like the coordinate
+// system.
+//
+// Tiles with an index out of the range of the atlas, such as -1, are empty and nothing is drawn for
+// them.
+type TileMap struct {
+	pic      Picture
+	tileSize Vec
+	width    int
+	height   int
+	tiles    []int
+
+	batch  *Batch
+	sprite *Sprite
+	view   Rect
+	dirty  bool
+}
+
+// NewTileMap creates a TileMap with the supplied tile atlas and size of a tile. The initial tiles
+// are copied from the grid, where grid[y][x] is the index of the tile at the position (x, y).
+//
+// The TileMap is as wide as the longest row of the grid, tiles missing in shorter rows are empty.
+//
+// Both components of the tile size must be positive, otherwise this function panics.
+func NewTileMap(atlas Picture, tileSize Vec, grid [][]int) *TileMap {
+	if tileSize.X <= 0 || tileSize.Y <= 0 {
+		panic(fmt.Errorf("NewTileMap: tile size %v is not positive", tileSize))
+	}
+
+	width := 0
+	for _, row := range grid {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	tm := &TileMap{
+		pic:      atlas,
+		tileSize: tileSize,
+		width:    width,
+		height:   len(grid),
+		tiles:    make([]int, width*len(grid)),
+		batch:    NewBatch(&TrianglesData{}, atlas),
+		sprite:   NewSprite(atlas, Rect{}),
+		dirty:    true,
+	}
+	for y := range grid {
+		for x := 0; x < width; x++ {
+			if x < len(grid[y]) {
+				tm.tiles[y*width+x] = grid[y][x]
+			} else {
+				tm.tiles[y*width+x] = -1
+			}
+		}
+	}
+	return tm
+}
+
+// Size returns the number of tiles in each row and column of the TileMap.
+func (tm *TileMap) Size() (width, height int) {
+	return tm.width, tm.height
+}
+
+// Bounds returns the rectangle covered by all tiles of the TileMap.
+func (tm *TileMap) Bounds() Rect {
+	return R(0, 0, float64(tm.width)*tm.tileSize.X, float64(tm.height)*tm.tileSize.Y)
+}
+
+// Tile returns the index of the tile at the position (x, y).
+func (tm *TileMap) Tile(x, y int) int {
+	return tm.tiles[tm.index(x, y)]
+}
+
+// SetTile sets the index of the tile at the position (x, y).
+func (tm *TileMap) SetTile(x, y, index int) {
+	i := tm.index(x, y)
+	if tm.tiles[i] != index {
+		tm.tiles[i] = index
+		tm.dirty = true
+	}
+}
+
+// Draw draws all tiles of the TileMap, which are at least partially visible in the given view,
+// onto the provided Target. The view is in the coordinates of the TileMap, before the Target's
+// Matrix is applied.
+//
+// The visible tiles are only collected again when the view or a tile changes.
+func (tm *TileMap) Draw(t Target, view Rect) {
+	view = view.Norm()
+	if tm.dirty || view != tm.view {
+		tm.view = view
+		tm.dirty = false
+		tm.rebuild()
+	}
+	tm.batch.Draw(t)
+}
+
+func (tm *TileMap) index(x, y int) int {
+	if x < 0 || x >= tm.width || y < 0 || y >= tm.height {
+		panic(fmt.Errorf("(%T): tile position (%d, %d) out of bounds", tm, x, y))
+	}
+	return y*tm.width + x
+}
+
+func (tm *TileMap) rebuild() {
+	tm.batch.Clear()
+
+	var (
+		atlas = tm.pic.Bounds()
+		cols  = int(atlas.W() / tm.tileSize.X)
+		rows  = int(atlas.H() / tm.tileSize.Y)
+		x0    = int(math.Max(0, math.Floor(tm.view.Min.X/tm.tileSize.X)))
+		y0    = int(math.Max(0, math.Floor(tm.view.Min.Y/tm.tileSize.Y)))
+		x1    = int(math.Min(float64(tm.width), math.Ceil(tm.view.Max.X/tm.tileSize.X)))
+		y1    = int(math.Min(float64(tm.height), math.Ceil(tm.view.Max.Y/tm.tileSize.Y)))
+	)
+
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			index := tm.tiles[y*tm.width+x]
+			if index < 0 || index >= cols*rows {
+				continue
+			}
+			min := V(
+				atlas.Min.X+float64(index%cols)*tm.tileSize.X,
+				atlas.Max.Y-float64(index/cols+1)*tm.tileSize.Y,
+			)
+			tm.sprite.Set(tm.pic, Rect{Min: min, Max: min.Add(tm.tileSize)})
+			tm.sprite.Draw(tm.batch, IM.Moved(V(float64(x)+0.5, float64(y)+0.5).ScaledXY(tm.tileSize)))
+		}
+	}
+}
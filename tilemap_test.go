@@ -0,0 +1,77 @@
+package pixel_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestTileMap_Draw(t *testing.T) {
+	// 2x2 atlas of single pixel tiles: red, green on the top row; blue, white on the bottom row
+	atlas := pixel.MakePictureData(pixel.R(0, 0, 2, 2))
+	atlas.Pix[atlas.Index(pixel.V(0, 1))] = color.RGBA{R: 0xff, A: 0xff}
+	atlas.Pix[atlas.Index(pixel.V(1, 1))] = color.RGBA{G: 0xff, A: 0xff}
+	atlas.Pix[atlas.Index(pixel.V(0, 0))] = color.RGBA{B: 0xff, A: 0xff}
+	atlas.Pix[atlas.Index(pixel.V(1, 0))] = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	tm := pixel.NewTileMap(atlas, pixel.V(1, 1), [][]int{
+		{0, 1, 2},
+		{3, -1},
+		{4, 0, 1},
+	})
+	tm.SetTile(2, 2, 3)
+
+	tests := []struct {
+		name string
+		view pixel.Rect
+		want [][]pixel.RGBA // want[y][x]
+	}{
+		{
+			name: "Whole map",
+			view: tm.Bounds(),
+			want: [][]pixel.RGBA{
+				{pixel.RGB(1, 0, 0), pixel.RGB(0, 1, 0), pixel.RGB(0, 0, 1)},
+				{pixel.RGB(1, 1, 1), {}, {}},
+				{{}, pixel.RGB(1, 0, 0), pixel.RGB(1, 1, 1)},
+			},
+		},
+		{
+			name: "Culled",
+			view: pixel.R(0.5, 0.5, 1.5, 1),
+			want: [][]pixel.RGBA{
+				{pixel.RGB(1, 0, 0), pixel.RGB(0, 1, 0), {}},
+				{{}, {}, {}},
+				{{}, {}, {}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := pixel.NewImageTarget(tm.Bounds())
+			tm.Draw(it, tt.view)
+			for y := range tt.want {
+				for x := range tt.want[y] {
+					at := pixel.V(float64(x)+0.5, float64(y)+0.5)
+					if got := it.Color(at); got != tt.want[y][x] {
+						t.Errorf("tile (%d, %d) = %v, want %v", x, y, got, tt.want[y][x])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewTileMapInvalidTileSize(t *testing.T) {
+	atlas := pixel.MakePictureData(pixel.R(0, 0, 2, 2))
+	for _, size := range []pixel.Vec{pixel.V(0, 1), pixel.V(1, -1), pixel.ZV} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewTileMap() did not panic with tile size %v", size)
+				}
+			}()
+			pixel.NewTileMap(atlas, size, [][]int{{0}})
+		}()
+	}
+}
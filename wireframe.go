@@ -0,0 +1,92 @@
+package pixel
+
+import "image/color"
+
+// WireframeTarget is a Target which wraps another Target and draws all triangles drawn onto it as
+// wireframes. Instead of filling a triangle, it's three edges are drawn as lines using the colors
+// of the vertices. Pictures are not drawn.
+//
+// This is useful for debugging geometry. Since WireframeTarget is a Target itself, you can
+// temporarily draw your scene onto it instead of the original Target without changing any other
+// code:
+//
+//   var target pixel.BasicTarget = win
+//   if debug {
+//       target = pixel.NewWireframeTarget(win, 1)
+//   }
+//
+// The wireframe is generated again in each Draw, so drawing with it is slower than usual.
+type WireframeTarget struct {
+	dst       Target
+	thickness float64
+}
+
+var _ BasicTarget = (*WireframeTarget)(nil)
+
+// NewWireframeTarget creates a WireframeTarget which draws onto the supplied Target with lines of
+// the given thickness. The thickness is in the coordinates of the drawn triangles, so it's affected
+// by the Matrix of the supplied Target.
+func NewWireframeTarget(t Target, thickness float64) *WireframeTarget {
+	return &WireframeTarget{
+		dst:       t,
+		thickness: thickness,
+	}
+}
+
+// MakeTriangles creates a specialized copy of the supplied Triangles that draws it's wireframe onto
+// the wrapped Target.
+func (wt *WireframeTarget) MakeTriangles(t Triangles) TargetTriangles {
+	return newWrappedTriangles(t, wt, wt.dst, wt.lines)
+}
+
+// MakePicture creates a specialized copy of the supplied Picture. The Picture itself is never drawn,
+// drawing Triangles with it draws just their wireframe.
+func (wt *WireframeTarget) MakePicture(p Picture) TargetPicture {
+	return &wrappedPicture{
+		bounds: p.Bounds(),
+		owner:  wt,
+	}
+}
+
+// SetMatrix sets a Matrix of the wrapped Target, if it's a BasicTarget. Otherwise it does nothing.
+func (wt *WireframeTarget) SetMatrix(m Matrix) {
+	if bt, ok := wt.dst.(BasicTarget); ok {
+		bt.SetMatrix(m)
+	}
+}
+
+// SetColorMask sets a color mask of the wrapped Target, if it's a BasicTarget. Otherwise it does
+// nothing.
+func (wt *WireframeTarget) SetColorMask(c color.Color) {
+	if bt, ok := wt.dst.(BasicTarget); ok {
+		bt.SetColorMask(c)
+	}
+}
+
+// lines appends two triangles for each edge of each triangle of src to out, forming a line of the
+// thickness of the WireframeTarget.
+func (wt *WireframeTarget) lines(out, src *TrianglesData) {
+	for i := 0; i+2 < src.Len(); i += 3 {
+		for k := 0; k < 3; k++ {
+			a, b := (*src)[i+k], (*src)[i+(k+1)%3]
+			n := a.Position.To(b.Position).Normal().Unit().Scaled(wt.thickness / 2)
+
+			off := out.Len()
+			out.SetLen(off + 6)
+			for j, v := range [...]struct {
+				pos Vec
+				col RGBA
+			}{
+				{a.Position.Add(n), a.Color},
+				{a.Position.Sub(n), a.Color},
+				{b.Position.Add(n), b.Color},
+				{b.Position.Add(n), b.Color},
+				{a.Position.Sub(n), a.Color},
+				{b.Position.Sub(n), b.Color},
+			} {
+				(*out)[off+j].Position = v.pos
+				(*out)[off+j].Color = v.col
+			}
+		}
+	}
+}
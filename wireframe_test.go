@@ -0,0 +1,41 @@
+package pixel_test
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestWireframeTarget(t *testing.T) {
+	it := pixel.NewImageTarget(pixel.R(0, 0, 16, 16))
+	wt := pixel.NewWireframeTarget(it, 2)
+
+	tris := pixel.MakeTrianglesData(3)
+	(*tris)[0].Position = pixel.V(1, 1)
+	(*tris)[1].Position = pixel.V(15, 1)
+	(*tris)[2].Position = pixel.V(1, 15)
+	tris.SetAllColors(pixel.RGB(1, 0, 0))
+
+	pic := pixel.MakePictureData(pixel.R(0, 0, 16, 16))
+	d := pixel.Drawer{Triangles: tris, Picture: pic}
+	d.Draw(wt)
+
+	tests := []struct {
+		name string
+		at   pixel.Vec
+		want pixel.RGBA
+	}{
+		{name: "Bottom edge", at: pixel.V(7.5, 1.5), want: pixel.RGB(1, 0, 0)},
+		{name: "Left edge", at: pixel.V(1.5, 7.5), want: pixel.RGB(1, 0, 0)},
+		{name: "Diagonal edge", at: pixel.V(7.5, 8.5), want: pixel.RGB(1, 0, 0)},
+		{name: "Inside", at: pixel.V(4.5, 4.5), want: pixel.RGBA{}},
+		{name: "Outside", at: pixel.V(12.5, 12.5), want: pixel.RGBA{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := it.Color(tt.at); got != tt.want {
+				t.Errorf("Color(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package pixel
+
+import "fmt"
+
+// wrappedTriangles are TargetTriangles of a Target, which wraps another Target, such as
+// WireframeTarget. The vertices are kept as they were supplied and each time they're drawn, the
+// transform generates the vertices actually drawn onto the wrapped Target from them.
+type wrappedTriangles struct {
+	src       *TrianglesData
+	out       *TrianglesData
+	tris      TargetTriangles
+	owner     Target
+	dst       Target
+	transform func(out, src *TrianglesData)
+}
+
+// newWrappedTriangles creates wrappedTriangles of the owner Target drawing onto the dst Target. The
+// transform appends the generated vertices to out, which is empty when it's called.
+func newWrappedTriangles(t Triangles, owner, dst Target, transform func(out, src *TrianglesData)) *wrappedTriangles {
+	return &wrappedTriangles{
+		src:       ToTrianglesData(t),
+		owner:     owner,
+		dst:       dst,
+		transform: transform,
+	}
+}
+
+func (wt *wrappedTriangles) Len() int {
+	return wt.src.Len()
+}
+
+func (wt *wrappedTriangles) SetLen(len int) {
+	wt.src.SetLen(len)
+}
+
+func (wt *wrappedTriangles) Slice(i, j int) Triangles {
+	return &wrappedTriangles{
+		src:       wt.src.Slice(i, j).(*TrianglesData),
+		owner:     wt.owner,
+		dst:       wt.dst,
+		transform: wt.transform,
+	}
+}
+
+func (wt *wrappedTriangles) Update(t Triangles) {
+	wt.src.Update(t)
+}
+
+func (wt *wrappedTriangles) Copy() Triangles {
+	return &wrappedTriangles{
+		src:       wt.src.Copy().(*TrianglesData),
+		owner:     wt.owner,
+		dst:       wt.dst,
+		transform: wt.transform,
+	}
+}
+
+// prepare updates the Triangles of the wrapped Target with the transformed vertices.
+func (wt *wrappedTriangles) prepare() {
+	if wt.out == nil {
+		wt.out = &TrianglesData{}
+	}
+	wt.out.SetLen(0)
+	wt.transform(wt.out, wt.src)
+
+	if wt.tris == nil {
+		wt.tris = wt.dst.MakeTriangles(wt.out)
+	} else {
+		wt.tris.SetLen(wt.out.Len())
+		wt.tris.Update(wt.out)
+	}
+}
+
+func (wt *wrappedTriangles) Draw() {
+	wt.prepare()
+	wt.tris.Draw()
+}
+
+// wrappedPicture is a TargetPicture of a Target, which wraps another Target. If pic is nil, the
+// Picture is not drawn, drawing Triangles with it draws them just like without a Picture.
+type wrappedPicture struct {
+	bounds Rect
+	pic    TargetPicture
+	owner  Target
+}
+
+func (wp *wrappedPicture) Bounds() Rect {
+	if wp.pic != nil {
+		return wp.pic.Bounds()
+	}
+	return wp.bounds
+}
+
+func (wp *wrappedPicture) Draw(t TargetTriangles) {
+	wt := t.(*wrappedTriangles)
+	if wp.owner != wt.owner {
+		panic(fmt.Errorf("(%T).Draw: TargetTriangles generated by different %T", wp, wp.owner))
+	}
+	if wp.pic == nil {
+		wt.Draw()
+		return
+	}
+	wt.prepare()
+	wp.pic.Draw(wt.tris)
+}